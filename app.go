@@ -16,6 +16,7 @@ import (
 
 	bam "github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -33,6 +34,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/supply"
 
 	"github.com/wirelineio/wns/gql"
+	"github.com/wirelineio/wns/x/auction"
 	"github.com/wirelineio/wns/x/bond"
 	ns "github.com/wirelineio/wns/x/nameservice"
 
@@ -65,18 +67,21 @@ var (
 
 		ns.AppModule{},
 		bond.AppModule{},
+		auction.AppModule{},
 	)
 
 	// Account permissions (https://github.com/cosmos/cosmos-sdk/blob/master/x/supply/spec/01_concepts.md).
 	maccPerms = map[string][]string{
-		auth.FeeCollectorName:          nil,
-		distr.ModuleName:               nil,
-		mint.ModuleName:                {supply.Minter},
-		staking.BondedPoolName:         {supply.Burner, supply.Staking},
-		staking.NotBondedPoolName:      {supply.Burner, supply.Staking},
-		gov.ModuleName:                 {supply.Burner},
-		bond.ModuleName:                nil,
-		ns.RecordRentModuleAccountName: nil,
+		auth.FeeCollectorName:             nil,
+		distr.ModuleName:                  nil,
+		mint.ModuleName:                   {supply.Minter},
+		staking.BondedPoolName:            {supply.Burner, supply.Staking},
+		staking.NotBondedPoolName:         {supply.Burner, supply.Staking},
+		gov.ModuleName:                    {supply.Burner},
+		bond.ModuleName:                   nil,
+		auction.ModuleName:                {supply.Burner},
+		ns.RecordRentModuleAccountName:    nil,
+		ns.AuthorityRentModuleAccountName: nil,
 	}
 )
 
@@ -112,6 +117,7 @@ type nameServiceApp struct {
 	paramsKeeper   params.Keeper
 	recordKeeper   ns.RecordKeeper
 	bondKeeper     bond.Keeper
+	auctionKeeper  auction.Keeper
 	nsKeeper       ns.Keeper
 
 	// Module Manager
@@ -126,6 +132,11 @@ func NewNameServiceApp(
 	// First define the top level codec that will be shared by the different modules
 	cdc := MakeCodec()
 
+	// Keep a reference to the multistore, for historical (height-pinned) GQL queries.
+	// Set before the other options, so that they (e.g. pruning) apply to it.
+	cms := store.NewCommitMultiStore(db)
+	baseAppOptions = append([]func(*bam.BaseApp){func(bApp *bam.BaseApp) { bApp.SetCMS(cms) }}, baseAppOptions...)
+
 	// BaseApp handles interactions with Tendermint through the ABCI protocol
 	bApp := bam.NewBaseApp(appName, logger, db, auth.DefaultTxDecoder(cdc), baseAppOptions...)
 
@@ -133,7 +144,7 @@ func NewNameServiceApp(
 
 	keys := sdk.NewKVStoreKeys(bam.MainStoreKey, auth.StoreKey, staking.StoreKey,
 		supply.StoreKey, distr.StoreKey, slashing.StoreKey, mint.StoreKey, gov.StoreKey, params.StoreKey,
-		ns.StoreKey, bond.StoreKey)
+		ns.StoreKey, bond.StoreKey, auction.StoreKey)
 
 	tkeys := sdk.NewTransientStoreKeys(staking.TStoreKey, params.TStoreKey)
 
@@ -159,6 +170,7 @@ func NewNameServiceApp(
 	crisisSubspace := app.paramsKeeper.Subspace(crisis.DefaultParamspace)
 	nsSubspace := app.paramsKeeper.Subspace(ns.DefaultParamspace)
 	bondSubspace := app.paramsKeeper.Subspace(bond.DefaultParamspace)
+	auctionSubspace := app.paramsKeeper.Subspace(auction.DefaultParamspace)
 
 	// The AccountKeeper handles address -> account lookups
 	app.accountKeeper = auth.NewAccountKeeper(
@@ -258,11 +270,21 @@ func NewNameServiceApp(
 		bondSubspace,
 	)
 
+	app.auctionKeeper = auction.NewKeeper(
+		app.accountKeeper,
+		app.bankKeeper,
+		app.supplyKeeper,
+		keys[auction.StoreKey],
+		app.cdc,
+		auctionSubspace,
+	)
+
 	app.nsKeeper = ns.NewKeeper(
 		app.accountKeeper,
 		app.supplyKeeper,
 		app.recordKeeper,
 		bond.BondClientKeeper(app.bondKeeper),
+		auction.AuctionClientKeeper(app.auctionKeeper),
 		keys[ns.StoreKey],
 		app.cdc,
 		nsSubspace,
@@ -275,6 +297,7 @@ func NewNameServiceApp(
 		bank.NewAppModule(app.bankKeeper, app.accountKeeper),
 		crisis.NewAppModule(&app.crisisKeeper),
 		bond.NewAppModule(app.bondKeeper),
+		auction.NewAppModule(app.auctionKeeper),
 		ns.NewAppModule(app.nsKeeper),
 		supply.NewAppModule(app.supplyKeeper, app.accountKeeper),
 		gov.NewAppModule(app.govKeeper, app.supplyKeeper),
@@ -285,7 +308,7 @@ func NewNameServiceApp(
 	)
 
 	app.mm.SetOrderBeginBlockers(distr.ModuleName, slashing.ModuleName, mint.ModuleName)
-	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName, ns.ModuleName)
+	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName, auction.ModuleName, ns.ModuleName)
 
 	// Sets the order of Genesis - Order matters, genutil is to always come last
 	// NOTE: The genutils moodule must occur after staking so that pools are
@@ -300,6 +323,7 @@ func NewNameServiceApp(
 		mint.ModuleName,
 		gov.ModuleName,
 		bond.ModuleName,
+		auction.ModuleName,
 		ns.ModuleName,
 		supply.ModuleName,
 		crisis.ModuleName,
@@ -334,7 +358,7 @@ func NewNameServiceApp(
 		}
 	}
 
-	go gql.Server(app.BaseApp, app.cdc, app.nsKeeper, app.bondKeeper, app.accountKeeper)
+	go gql.Server(app.BaseApp, cms, app.cdc, app.nsKeeper, app.bondKeeper, app.auctionKeeper, app.accountKeeper)
 
 	return app
 }
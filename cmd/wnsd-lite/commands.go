@@ -26,7 +26,7 @@ var versionCmd = &cobra.Command{
 var initCmd = &cobra.Command{
 	Use:   "init",
 	Short: "Initialize the WNS lite node",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		logLevel, _ := cmd.Flags().GetString("log-level")
 		chainID, _ := cmd.Flags().GetString("chain-id")
 		home, _ := cmd.Flags().GetString("home")
@@ -34,6 +34,16 @@ var initCmd = &cobra.Command{
 		height, _ := cmd.Flags().GetInt64("height")
 		initFromNode, _ := cmd.Flags().GetBool("from-node")
 		initFromGenesisFile, _ := cmd.Flags().GetBool("from-genesis-file")
+		initFromSnapshot, _ := cmd.Flags().GetString("from-snapshot")
+		crossCheckNodes, _ := cmd.Flags().GetStringSlice("cross-check-nodes")
+		syncAuthorities, _ := cmd.Flags().GetStringSlice("sync-authorities")
+		syncNamePrefixes, _ := cmd.Flags().GetStringSlice("sync-name-prefixes")
+		syncRecordAttributes, _ := cmd.Flags().GetStringSlice("sync-record-attributes")
+
+		syncFilter, err := sync.NewSyncFilter(syncAuthorities, syncNamePrefixes, syncRecordAttributes)
+		if err != nil {
+			return err
+		}
 
 		config := sync.Config{
 			LogLevel:            logLevel,
@@ -42,10 +52,77 @@ var initCmd = &cobra.Command{
 			NodeAddress:         nodeAddress,
 			InitFromNode:        initFromNode,
 			InitFromGenesisFile: initFromGenesisFile,
+			InitFromSnapshot:    initFromSnapshot,
+			CrossCheckNodes:     crossCheckNodes,
+			SyncFilter:          syncFilter,
 		}
 		ctx := sync.NewContext(&config)
 
 		sync.Init(ctx, height)
+
+		return nil
+	},
+}
+
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Manage WNS lite node snapshots",
+}
+
+var snapshotExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the WNS lite node state to a snapshot (the node must be stopped)",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logLevel, _ := cmd.Flags().GetString("log-level")
+		chainID, _ := cmd.Flags().GetString("chain-id")
+		home, _ := cmd.Flags().GetString("home")
+		nodeAddress, _ := cmd.Flags().GetString("node")
+		output, _ := cmd.Flags().GetString("output")
+
+		config := sync.Config{
+			LogLevel:    logLevel,
+			ChainID:     chainID,
+			Home:        home,
+			NodeAddress: nodeAddress,
+		}
+		ctx := sync.NewContext(&config)
+
+		metadata, err := sync.ExportSnapshot(ctx, output)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println("Exported snapshot at height", metadata.Height, "to", output)
+
+		return nil
+	},
+}
+
+var rollbackCmd = &cobra.Command{
+	Use:   "rollback",
+	Short: "Roll back the WNS lite node state to an earlier height (the node must be stopped)",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logLevel, _ := cmd.Flags().GetString("log-level")
+		chainID, _ := cmd.Flags().GetString("chain-id")
+		home, _ := cmd.Flags().GetString("home")
+		height, _ := cmd.Flags().GetInt64("height")
+
+		// Rollback only uses the local undo data, so doesn't need an RPC node.
+		config := sync.Config{
+			LogLevel: logLevel,
+			ChainID:  chainID,
+			Home:     home,
+		}
+		ctx := sync.NewContext(&config)
+
+		err := sync.Rollback(ctx, height)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println("Rolled back to height", height)
+
+		return nil
 	},
 }
 
@@ -58,15 +135,31 @@ var startCmd = &cobra.Command{
 		home, _ := cmd.Flags().GetString("home")
 		nodeAddress, _ := cmd.Flags().GetString("node")
 		endpoint, _ := cmd.Flags().GetString("endpoint")
+		discoveryRecordType, _ := cmd.Flags().GetString("discovery-record-type")
+		discoveryAttributePath, _ := cmd.Flags().GetString("discovery-attribute-path")
+		endpointsFile, _ := cmd.Flags().GetString("endpoints-file")
+		discoveryDNSSRV, _ := cmd.Flags().GetString("discovery-dns-srv")
 		syncTimeoutMins, _ := cmd.Flags().GetInt("sync-timeout")
+		syncConcurrency, _ := cmd.Flags().GetInt("sync-concurrency")
+		peers, _ := cmd.Flags().GetStringSlice("peers")
+		peerListenAddress, _ := cmd.Flags().GetString("peer-laddr")
+		undoHeights, _ := cmd.Flags().GetInt64("undo-heights")
 
 		config := sync.Config{
-			LogLevel:        logLevel,
-			ChainID:         chainID,
-			Home:            home,
-			NodeAddress:     nodeAddress,
-			Endpoint:        endpoint,
-			SyncTimeoutMins: syncTimeoutMins,
+			LogLevel:               logLevel,
+			ChainID:                chainID,
+			Home:                   home,
+			NodeAddress:            nodeAddress,
+			Endpoint:               endpoint,
+			DiscoveryRecordType:    discoveryRecordType,
+			DiscoveryAttributePath: discoveryAttributePath,
+			EndpointsFile:          endpointsFile,
+			DiscoveryDNSSRV:        discoveryDNSSRV,
+			SyncTimeoutMins:        syncTimeoutMins,
+			SyncConcurrency:        syncConcurrency,
+			Peers:                  peers,
+			PeerListenAddress:      peerListenAddress,
+			UndoHeights:            undoHeights,
 		}
 
 		ctx := sync.NewContext(&config)
@@ -79,9 +172,25 @@ var startCmd = &cobra.Command{
 
 func init() {
 	// Init command flags.
-	initCmd.Flags().Bool("from-node", false, "Initialize from trusted node")
+	initCmd.Flags().Bool("from-node", false, "Initialize from node (values are verified using light client proofs, and the keys listed by the node are cross-checked against --cross-check-nodes)")
+	initCmd.Flags().StringSlice("cross-check-nodes", []string{}, "Other RPC nodes (e.g. tcp://wns2:26657) to cross-check the keys listed by the node against (required with --from-node)")
 	initCmd.Flags().Bool("from-genesis-file", false, "Initialize from genesis file")
 	initCmd.Flags().Int64("height", 1, "Initial height (if using --from-genesis-file option)")
+	initCmd.Flags().String("from-snapshot", "", "Initialize from snapshot file (app hash is verified using light client proofs)")
+
+	// Partial sync, e.g. for edge nodes that only need names under a few authorities.
+	// The filter is saved at init and can't be changed later (requires a fresh init).
+	initCmd.Flags().StringSlice("sync-authorities", []string{}, "Only sync names under these authorities (and sub-authorities)")
+	initCmd.Flags().StringSlice("sync-name-prefixes", []string{}, "Only sync names with these WRN prefixes (e.g. wrn://wireline/bots/)")
+	initCmd.Flags().StringSlice("sync-record-attributes", []string{}, "Only sync records matching all these attributes (e.g. type=wrn:bot), besides records of synced names")
+
+	// Snapshot command flags.
+	snapshotExportCmd.Flags().String("output", "wnsd-lite-snapshot.tar.gz", "Snapshot file to write")
+	snapshotCmd.AddCommand(snapshotExportCmd)
+
+	// Rollback command flags.
+	rollbackCmd.Flags().Int64("height", 0, "Height to roll back to (must be within the undo window)")
+	rollbackCmd.MarkFlagRequired("height")
 
 	// Start command flags.
 	startCmd.Flags().Bool("gql-server", true, "Start GQL server")
@@ -90,8 +199,24 @@ func init() {
 	startCmd.Flags().String("gql-playground-api-base", "", "GQL API base path to use in GQL playground")
 	startCmd.Flags().String("endpoint", "", "WNS GQL endpoint to discover additional RPC nodes")
 
+	// Other RPC endpoint discovery options, which can be combined (nodes no longer discovered are removed).
+	startCmd.Flags().String("discovery-record-type", sync.DefaultDiscoveryRecordType, "Type of WNS records with RPC endpoints (used with --endpoint)")
+	startCmd.Flags().String("discovery-attribute-path", sync.DefaultDiscoveryAttributePath, "Path of the RPC endpoint in WNS records, i.e. attribute key followed by JSON fields (used with --endpoint)")
+	startCmd.Flags().String("endpoints-file", "", "File with RPC endpoints (one per line), re-read on change")
+	startCmd.Flags().String("discovery-dns-srv", "", "DNS SRV name to look up RPC endpoints (e.g. _wns-rpc._tcp.example.com)")
+
 	// Node can be configured to exit if no sync progress can be made in the past N minutes.
 	// sync-timeout controls that duration e.g., 10mins.
 	// Negative values disable the sync timeout.
 	startCmd.Flags().Int("sync-timeout", 10, "Sync timeout in minutes")
+
+	// Changeset keys (and several heights ahead) are fetched concurrently, spread across the RPC nodes.
+	startCmd.Flags().Int("sync-concurrency", sync.DefaultSyncConcurrency, "Max. number of concurrent RPC requests during sync")
+
+	// Lite nodes can serve the values they've verified to other lite nodes, which verify them again (see sync/peer.go).
+	startCmd.Flags().StringSlice("peers", []string{}, "Lite node addresses (e.g. tcp://lite1:26667) to use as secondary sources for sync")
+	startCmd.Flags().String("peer-laddr", "", "Address to serve verified state to lite peers on (e.g. tcp://0.0.0.0:26667), disabled if empty")
+
+	// Undo data is kept for recent heights, so that the node can be rolled back after divergence (see sync/undo.go).
+	startCmd.Flags().Int64("undo-heights", sync.DefaultUndoHeights, "Number of recent heights the node can be rolled back, 0 to disable")
 }
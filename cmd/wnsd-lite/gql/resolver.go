@@ -6,11 +6,17 @@ package gql
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"os"
 	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/wirelineio/wns/cmd/wnsd-lite/sync"
 	baseGql "github.com/wirelineio/wns/gql"
+	"github.com/wirelineio/wns/x/bond"
 	"github.com/wirelineio/wns/x/nameservice"
 )
 
@@ -19,8 +25,10 @@ var LiteNodeDataPath = os.ExpandEnv("$HOME/.wire/wnsd-lite/data")
 
 // Resolver is the GQL query resolver.
 type Resolver struct {
-	Keeper  *sync.Keeper
-	LogFile string
+	Keeper      *sync.Keeper
+	SyncContext *sync.Context
+	Publisher   *baseGql.ChangesetPublisher
+	LogFile     string
 }
 
 type queryResolver struct{ *Resolver }
@@ -30,7 +38,140 @@ func (r *Resolver) Query() baseGql.QueryResolver {
 	return &queryResolver{r}
 }
 
-func (r *queryResolver) GetRecordsByIds(ctx context.Context, ids []string) ([]*baseGql.Record, error) {
+// NameRecord is the entry point to name record field resolution.
+func (r *Resolver) NameRecord() baseGql.NameRecordResolver {
+	return &nameRecordResolver{r}
+}
+
+// Record is the entry point to record field resolution.
+func (r *Resolver) Record() baseGql.RecordResolver {
+	return &recordResolver{r}
+}
+
+// ResultMeta is the entry point to result metadata field resolution.
+func (r *Resolver) ResultMeta() baseGql.ResultMetaResolver {
+	return &resultMetaResolver{r}
+}
+
+// getProof returns the proof of the synced store value.
+func (r *Resolver) getProof(key []byte) *baseGql.Proof {
+	proof, value := r.Keeper.GetProof(key)
+	if proof == nil {
+		return nil
+	}
+
+	return baseGql.GetGQLProof(proof.Height, proof.AppHash, key, value, proof.Proof)
+}
+
+type nameRecordResolver struct{ *Resolver }
+
+func (r *nameRecordResolver) Proof(ctx context.Context, obj *baseGql.NameRecord) (*baseGql.Proof, error) {
+	return r.getProof(nameservice.GetNameRecordIndexKey(obj.Name)), nil
+}
+
+type recordResolver struct{ *Resolver }
+
+func (r *recordResolver) Proof(ctx context.Context, obj *baseGql.Record) (*baseGql.Proof, error) {
+	return r.getProof(nameservice.GetRecordIndexKey(nameservice.ID(obj.ID))), nil
+}
+
+// Submit validates the tx and forwards it to a full-node from the RPC node pool.
+func (r *mutationResolver) Submit(ctx context.Context, tx string, mode *baseGql.BroadcastMode, waitForSync *bool) (*string, error) {
+	stdTx, err := baseGql.DecodeStdTx(r.SyncContext.Codec(), tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reject invalid txs without forwarding them (full-nodes check them again).
+	if err := stdTx.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	for _, msg := range stdTx.GetMsgs() {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+
+	broadcastMode := baseGql.BroadcastModeCommit
+	if mode != nil {
+		broadcastMode = *mode
+	}
+
+	result, err := r.SyncContext.BroadcastTx(stdTx, string(broadcastMode))
+	if err != nil {
+		return nil, err
+	}
+
+	if waitForSync != nil && *waitForSync {
+		err = r.SyncContext.WaitForSync(result)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	jsonBytes, err := json.MarshalIndent(result.Result, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	jsonResponse := string(jsonBytes)
+
+	return &jsonResponse, nil
+}
+
+type resultMetaResolver struct{ *Resolver }
+
+// Proof isn't available, as lite nodes only have proofs for the (individual) values synced at different heights.
+func (r *resultMetaResolver) Proof(ctx context.Context, obj *baseGql.ResultMeta) (*baseGql.StateProof, error) {
+	return nil, nil
+}
+
+type subscriptionResolver struct{ *Resolver }
+
+// Subscription is the entry point to subscriptions.
+func (r *Resolver) Subscription() baseGql.SubscriptionResolver {
+	return &subscriptionResolver{r}
+}
+
+func (r *subscriptionResolver) OnNameChanged(ctx context.Context, prefix *string) (<-chan *baseGql.NameChange, error) {
+	return baseGql.SubscribeNameChanges(ctx, syncedQueryResolver{&queryResolver{r.Resolver}}, r.Publisher, prefix)
+}
+
+func (r *subscriptionResolver) OnRecordChanged(ctx context.Context, attributes []*baseGql.KeyValueInput) (<-chan *baseGql.RecordChange, error) {
+	return baseGql.SubscribeRecordChanges(ctx, &queryResolver{r.Resolver}, r.Publisher, func(id nameservice.ID) *nameservice.Record {
+		if !r.Keeper.HasRecord(id) {
+			return nil
+		}
+
+		record := r.Keeper.GetRecord(id)
+
+		return &record
+	}, attributes)
+}
+
+func (r *subscriptionResolver) OnAuthorityChanged(ctx context.Context, prefix *string) (<-chan *baseGql.AuthorityChange, error) {
+	return baseGql.SubscribeAuthorityChanges(ctx, syncedQueryResolver{&queryResolver{r.Resolver}}, r.Publisher, prefix)
+}
+
+// syncedQueryResolver looks up subscription changes at the synced height, as lite nodes don't keep historical state.
+// If the name (or authority) changed again since, that change is notified next.
+type syncedQueryResolver struct{ *queryResolver }
+
+func (r syncedQueryResolver) LookupNames(ctx context.Context, names []string, height *string) (*baseGql.NameResult, error) {
+	return r.queryResolver.LookupNames(ctx, names, nil)
+}
+
+func (r syncedQueryResolver) LookupAuthorities(ctx context.Context, names []string, height *string) (*baseGql.AuthorityResult, error) {
+	return r.queryResolver.LookupAuthorities(ctx, names, nil)
+}
+
+func (r *queryResolver) GetRecordsByIds(ctx context.Context, ids []string, height *string) ([]*baseGql.Record, error) {
+	err := r.checkHeight(height)
+	if err != nil {
+		return nil, err
+	}
+
 	records := make([]*baseGql.Record, len(ids))
 	for index, id := range ids {
 		record, err := r.GetRecord(ctx, id)
@@ -44,17 +185,70 @@ func (r *queryResolver) GetRecordsByIds(ctx context.Context, ids []string) ([]*b
 	return records, nil
 }
 
+// GetRecordVersions gets the version chain (oldest first) of a record.
+func (r *queryResolver) GetRecordVersions(ctx context.Context, id string) ([]*baseGql.Record, error) {
+	versions := r.Keeper.GetRecordVersions(nameservice.ID(id))
+	records := make([]*baseGql.Record, len(versions))
+	for index := range versions {
+		record, err := baseGql.GetGQLRecord(ctx, r, &versions[index])
+		if err != nil {
+			return nil, err
+		}
+
+		records[index] = record
+	}
+
+	return records, nil
+}
+
 // QueryRecords filters records by K=V conditions.
-func (r *queryResolver) QueryRecords(ctx context.Context, attributes []*baseGql.KeyValueInput, all *bool) ([]*baseGql.Record, error) {
-	var records = r.Keeper.MatchRecords(func(record *nameservice.Record) bool {
-		return baseGql.MatchOnAttributes(record, attributes, (all != nil && *all))
+func (r *queryResolver) QueryRecords(ctx context.Context, attributes []*baseGql.KeyValueInput, filter *baseGql.RecordFilterInput, all *bool, height *string) ([]*baseGql.Record, error) {
+	result, err := r.QueryRecordsPage(ctx, attributes, filter, all, nil, nil, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Records, nil
+}
+
+// QueryRecordsPage filters records by K=V conditions, a page at a time.
+func (r *queryResolver) QueryRecordsPage(ctx context.Context, attributes []*baseGql.KeyValueInput, filter *baseGql.RecordFilterInput, all *bool, limit *int, cursor *string, height *string) (*baseGql.RecordResult, error) {
+	err := r.checkHeight(height)
+	if err != nil {
+		return nil, err
+	}
+
+	pageLimit, pageCursor := baseGql.GetPageParams(limit, cursor)
+	records, nextCursor, err := r.Keeper.MatchRecords(baseGql.GetIndexedAttributes(attributes, filter), pageCursor, pageLimit, func(record *nameservice.Record) bool {
+		return baseGql.MatchOnAttributes(record, attributes, filter, (all != nil && *all))
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	gqlResponse, err := baseGql.QueryRecords(ctx, r, records, attributes)
+	if err != nil {
+		return nil, err
+	}
 
-	return baseGql.QueryRecords(ctx, r, records, attributes)
+	result := baseGql.RecordResult{
+		Meta: baseGql.ResultMeta{
+			Height:     strconv.FormatInt(r.Keeper.GetStatusRecord().LastSyncedHeight, 10),
+			NextCursor: baseGql.GetNextCursor(nextCursor),
+		},
+		Records: gqlResponse,
+	}
+
+	return &result, nil
 }
 
 // ResolveRecords resolves records by ref/WRN, with semver range support.
-func (r *queryResolver) ResolveNames(ctx context.Context, names []string) (*baseGql.RecordResult, error) {
+func (r *queryResolver) ResolveNames(ctx context.Context, names []string, height *string) (*baseGql.RecordResult, error) {
+	err := r.checkHeight(height)
+	if err != nil {
+		return nil, err
+	}
+
 	gqlResponse := []*baseGql.Record{}
 
 	for _, name := range names {
@@ -77,7 +271,12 @@ func (r *queryResolver) ResolveNames(ctx context.Context, names []string) (*base
 	return &result, nil
 }
 
-func (r *queryResolver) LookupAuthorities(ctx context.Context, names []string) (*baseGql.AuthorityResult, error) {
+func (r *queryResolver) LookupAuthorities(ctx context.Context, names []string, height *string) (*baseGql.AuthorityResult, error) {
+	err := r.checkHeight(height)
+	if err != nil {
+		return nil, err
+	}
+
 	gqlResponse := []*baseGql.AuthorityRecord{}
 
 	for _, name := range names {
@@ -100,12 +299,17 @@ func (r *queryResolver) LookupAuthorities(ctx context.Context, names []string) (
 	return &result, nil
 }
 
-func (r *queryResolver) LookupNames(ctx context.Context, names []string) (*baseGql.NameResult, error) {
+func (r *queryResolver) LookupNames(ctx context.Context, names []string, height *string) (*baseGql.NameResult, error) {
+	err := r.checkHeight(height)
+	if err != nil {
+		return nil, err
+	}
+
 	gqlResponse := []*baseGql.NameRecord{}
 
 	for _, name := range names {
 		record := r.Keeper.GetNameRecord(name)
-		gqlRecord, err := baseGql.GetGQLNameRecord(ctx, r, record)
+		gqlRecord, err := baseGql.GetGQLNameRecord(ctx, r, name, record)
 		if err != nil {
 			return nil, err
 		}
@@ -143,9 +347,53 @@ func (r *queryResolver) GetStatus(ctx context.Context) (*baseGql.Status, error)
 			CatchingUp:        statusRecord.CatchingUp,
 		},
 		DiskUsage: diskUsage,
+		RPCNodes:  getRPCNodeInfo(r.SyncContext.GetRPCNodeStats()),
+		LitePeers: getRPCNodeInfo(r.SyncContext.GetPeerNodeStats()),
 	}, nil
 }
 
+func getRPCNodeInfo(nodeStats []sync.RPCNodeStats) []*baseGql.RPCNodeInfo {
+	nodes := make([]*baseGql.RPCNodeInfo, len(nodeStats))
+	for index, stats := range nodeStats {
+		nodes[index] = &baseGql.RPCNodeInfo{
+			Address:            stats.Address,
+			Calls:              strconv.FormatInt(stats.Calls, 10),
+			Errors:             strconv.FormatInt(stats.Errors, 10),
+			VerificationErrors: strconv.FormatInt(stats.VerificationErrors, 10),
+			ErrorRate:          stats.ErrorRate,
+			Latency:            stats.Latency.String(),
+			Score:              stats.Score,
+			QuarantinedUntil:   getOptionalTime(stats.QuarantinedUntil),
+			LastCalledAt:       getOptionalTime(stats.LastCalledAt),
+		}
+	}
+
+	return nodes
+}
+
+func getOptionalTime(value time.Time) *string {
+	if value.IsZero() {
+		return nil
+	}
+
+	timeStr := string(sdk.FormatTimeBytes(value))
+	return &timeStr
+}
+
+// checkHeight checks that the query is for the latest (synced) height, as lite nodes don't keep historical state.
+func (r *queryResolver) checkHeight(height *string) error {
+	if height == nil {
+		return nil
+	}
+
+	lastSyncedHeight := r.Keeper.GetStatusRecord().LastSyncedHeight
+	if *height != strconv.FormatInt(lastSyncedHeight, 10) {
+		return fmt.Errorf("historical queries are not supported by lite nodes (synced height: %d)", lastSyncedHeight)
+	}
+
+	return nil
+}
+
 func (r *queryResolver) GetRecord(ctx context.Context, id string) (*baseGql.Record, error) {
 	dbID := nameservice.ID(id)
 	if r.Keeper.HasRecord(dbID) {
@@ -157,3 +405,67 @@ func (r *queryResolver) GetRecord(ctx context.Context, id string) (*baseGql.Reco
 
 	return nil, nil
 }
+
+func (r *queryResolver) GetBondsByIds(ctx context.Context, ids []string) ([]*baseGql.Bond, error) {
+	bonds := make([]*baseGql.Bond, len(ids))
+	for index, id := range ids {
+		bondObj, err := r.GetBond(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		bonds[index] = bondObj
+	}
+
+	return bonds, nil
+}
+
+func (r *queryResolver) GetBond(ctx context.Context, id string) (*baseGql.Bond, error) {
+	dbID := bond.ID(id)
+	if r.Keeper.HasBond(dbID) {
+		bondObj := r.Keeper.GetBond(dbID)
+		return baseGql.GetGQLBond(ctx, r, &bondObj)
+	}
+
+	return nil, nil
+}
+
+func (r *queryResolver) QueryBonds(ctx context.Context, attributes []*baseGql.KeyValueInput) ([]*baseGql.Bond, error) {
+	result, err := r.QueryBondsPage(ctx, attributes, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Bonds, nil
+}
+
+func (r *queryResolver) QueryBondsPage(ctx context.Context, attributes []*baseGql.KeyValueInput, limit *int, cursor *string) (*baseGql.BondResult, error) {
+	gqlResponse := []*baseGql.Bond{}
+
+	pageLimit, pageCursor := baseGql.GetPageParams(limit, cursor)
+	bonds, nextCursor, err := r.Keeper.MatchBonds(pageCursor, pageLimit, func(bondObj *bond.Bond) bool {
+		return baseGql.MatchBondOnAttributes(bondObj, attributes)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, bondObj := range bonds {
+		gqlBond, err := baseGql.GetGQLBond(ctx, r, bondObj)
+		if err != nil {
+			return nil, err
+		}
+
+		gqlResponse = append(gqlResponse, gqlBond)
+	}
+
+	result := baseGql.BondResult{
+		Meta: baseGql.ResultMeta{
+			Height:     strconv.FormatInt(r.Keeper.GetStatusRecord().LastSyncedHeight, 10),
+			NextCursor: baseGql.GetNextCursor(nextCursor),
+		},
+		Bonds: gqlResponse,
+	}
+
+	return &result, nil
+}
@@ -23,11 +23,6 @@ func (r *mutationResolver) InsertRecord(ctx context.Context, attributes []*baseG
 	return nil, errors.New("Not supported")
 }
 
-func (r *mutationResolver) Submit(ctx context.Context, tx string) (*string, error) {
-	// Only supported by a full-node.
-	return nil, errors.New("Not supported")
-}
-
 func (r *queryResolver) GetAccounts(ctx context.Context, addresses []string) ([]*baseGql.Account, error) {
 	// Only supported by a full-node.
 	return nil, errors.New("Not supported")
@@ -38,17 +33,7 @@ func (r *queryResolver) GetAccount(ctx context.Context, address string) (*baseGq
 	return nil, errors.New("Not supported")
 }
 
-func (r *queryResolver) GetBondsByIds(ctx context.Context, ids []string) ([]*baseGql.Bond, error) {
-	// Only supported by a full-node.
-	return nil, errors.New("Not supported")
-}
-
-func (r *queryResolver) GetBond(ctx context.Context, id string) (*baseGql.Bond, error) {
-	// Only supported by a full-node.
-	return nil, errors.New("Not supported")
-}
-
-func (r *queryResolver) QueryBonds(ctx context.Context, attributes []*baseGql.KeyValueInput) ([]*baseGql.Bond, error) {
+func (r *queryResolver) GetAuctionsByIds(ctx context.Context, ids []string) ([]*baseGql.Auction, error) {
 	// Only supported by a full-node.
 	return nil, errors.New("Not supported")
 }
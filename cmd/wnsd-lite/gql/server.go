@@ -5,10 +5,12 @@
 package gql
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/spf13/viper"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/handler"
 	"github.com/wirelineio/wns/cmd/wnsd-lite/sync"
 
@@ -35,19 +37,28 @@ func Server(ctx *sync.Context) {
 
 	keeper := sync.NewKeeper(ctx)
 
+	publisher := baseGql.NewChangesetPublisher()
+	ctx.OnChangeset(publisher.Publish)
+
 	logFile := viper.GetString("log-file")
 	apiBase := viper.GetString("gql-playground-api-base")
 
+	options := append(baseGql.WebsocketOptions(), handler.RequestMiddleware(partialViewMiddleware(ctx)))
+
 	router.Handle("/api", handler.GraphQL(baseGql.NewExecutableSchema(baseGql.Config{Resolvers: &Resolver{
-		Keeper:  keeper,
-		LogFile: logFile,
-	}})))
+		Keeper:      keeper,
+		SyncContext: ctx,
+		Publisher:   publisher,
+		LogFile:     logFile,
+	}}), options...))
 
 	// TODO(ashwin): Kept for backward compat.
 	router.Handle("/graphql", handler.GraphQL(baseGql.NewExecutableSchema(baseGql.Config{Resolvers: &Resolver{
-		Keeper:  keeper,
-		LogFile: logFile,
-	}})))
+		Keeper:      keeper,
+		SyncContext: ctx,
+		Publisher:   publisher,
+		LogFile:     logFile,
+	}}), options...))
 
 	if viper.GetBool("gql-playground") {
 		router.Handle("/webui", handler.Playground("WNS Lite", apiBase+"/api"))
@@ -61,3 +72,17 @@ func Server(ctx *sync.Context) {
 		panic(err)
 	}
 }
+
+// PartialViewExtension is the response extension set if the node is partially synced, with the sync filter as its value.
+// Clients should treat missing results as unknown rather than non-existent.
+const PartialViewExtension = "partialView"
+
+func partialViewMiddleware(ctx *sync.Context) graphql.RequestMiddleware {
+	return func(reqCtx context.Context, next func(reqCtx context.Context) []byte) []byte {
+		if filter := ctx.GetSyncFilter(); !filter.IsEmpty() {
+			graphql.GetRequestContext(reqCtx).RegisterExtension(PartialViewExtension, filter)
+		}
+
+		return next(reqCtx)
+	}
+}
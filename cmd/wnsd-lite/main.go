@@ -27,7 +27,7 @@ func main() {
 	rootCmd.PersistentFlags().StringP("node", "n", "tcp://localhost:26657", "Upstream WNS node RPC address")
 	rootCmd.PersistentFlags().String("log-file", "", "File to tail for GQL 'getLogs' API")
 
-	rootCmd.AddCommand(versionCmd, initCmd, startCmd)
+	rootCmd.AddCommand(versionCmd, initCmd, startCmd, snapshotCmd, rollbackCmd)
 
 	executor := cli.PrepareBaseCmd(rootCmd, "NSL", DefaultLightNodeHome)
 	err := executor.Execute()
@@ -0,0 +1,195 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package sync
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	pkgErrors "github.com/pkg/errors"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// Tx broadcast modes (see https://tendermint.com/rpc/#broadcasttxsync).
+const (
+	BroadcastModeSync   = "sync"
+	BroadcastModeAsync  = "async"
+	BroadcastModeCommit = "commit"
+)
+
+// BroadcastMaxAttempts is the max. number of RPC nodes a tx is forwarded to, in case of connection errors.
+const BroadcastMaxAttempts = 3
+
+// WaitForSyncTimeout is the max. duration to wait for the lite node to sync the block with a forwarded tx.
+const WaitForSyncTimeout = 60 * time.Second
+
+// WaitForSyncPollIntervalMillis is the interval to poll for tx inclusion and sync progress.
+const WaitForSyncPollIntervalMillis = 250
+
+// BroadcastResult is the result of a forwarded tx.
+type BroadcastResult struct {
+	// ResultBroadcastTxCommit in commit mode, ResultBroadcastTx otherwise.
+	Result interface{}
+	Hash   []byte
+
+	// Height of the block the tx was included in (commit mode only).
+	Height int64
+}
+
+// BroadcastTx forwards the tx to a healthy RPC node, using the broadcast mode.
+// Other nodes are only tried if the connection to the node failed, as the tx may have been accepted
+// after other network errors (e.g. read timeouts). In that case, the error has the tx hash to check for inclusion.
+func (ctx *Context) BroadcastTx(stdTx *auth.StdTx, mode string) (*BroadcastResult, error) {
+	txBytes, err := ctx.codec.MarshalBinaryLengthPrefixed(stdTx)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 1; ; attempt++ {
+		rpc := selectRPCNodeHandler(ctx)
+
+		ctx.log.Infoln("Forwarding tx to", rpc.Address, "mode:", mode)
+
+		result, err := rpc.broadcastTx(txBytes, mode)
+		if err == nil {
+			return result, nil
+		}
+
+		if isNetworkError(err) && !isConnectionError(err) {
+			return nil, fmt.Errorf("tx %X may have been broadcast, check if it's included in a block before resubmitting: %s",
+				tmtypes.Tx(txBytes).Hash(), err)
+		}
+
+		if !isConnectionError(err) || attempt == BroadcastMaxAttempts {
+			return nil, err
+		}
+
+		ctx.log.Errorln("Error forwarding tx to", rpc.Address, err)
+	}
+}
+
+// WaitForSync waits until the lite node has synced the block with the tx.
+// The inclusion height is looked up (by tx hash) if not known, i.e. not broadcast in commit mode.
+func (ctx *Context) WaitForSync(result *BroadcastResult) error {
+	deadline := time.Now().Add(WaitForSyncTimeout)
+
+	height := result.Height
+	for height == 0 {
+		// Errors until the tx is included in a block.
+		res, err := selectRPCNodeHandler(ctx).Client.Tx(result.Hash, false)
+		if err == nil {
+			height = res.Height
+			break
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for tx %X to be included in a block", result.Hash)
+		}
+
+		time.Sleep(WaitForSyncPollIntervalMillis * time.Millisecond)
+	}
+
+	for ctx.keeper.GetStatusRecord().LastSyncedHeight < height {
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for sync to height %d (tx %X)", height, result.Hash)
+		}
+
+		time.Sleep(WaitForSyncPollIntervalMillis * time.Millisecond)
+	}
+
+	return nil
+}
+
+func (rpcNodeHandler *RPCNodeHandler) broadcastTx(txBytes tmtypes.Tx, mode string) (*BroadcastResult, error) {
+	start := rpcNodeHandler.recordCall()
+
+	var result BroadcastResult
+	var err error
+
+	switch mode {
+	case BroadcastModeSync:
+		var res *ctypes.ResultBroadcastTx
+		res, err = rpcNodeHandler.Client.BroadcastTxSync(txBytes)
+		if err == nil {
+			result = BroadcastResult{Result: res, Hash: res.Hash}
+		}
+	case BroadcastModeAsync:
+		var res *ctypes.ResultBroadcastTx
+		res, err = rpcNodeHandler.Client.BroadcastTxAsync(txBytes)
+		if err == nil {
+			result = BroadcastResult{Result: res, Hash: res.Hash}
+		}
+	case BroadcastModeCommit:
+		var res *ctypes.ResultBroadcastTxCommit
+		res, err = rpcNodeHandler.Client.BroadcastTxCommit(txBytes)
+		if err == nil {
+			result = BroadcastResult{Result: res, Hash: res.Hash, Height: res.Height}
+		}
+	default:
+		return nil, fmt.Errorf("invalid broadcast mode: %s", mode)
+	}
+
+	if err != nil {
+		if isNetworkError(err) {
+			rpcNodeHandler.recordError()
+		}
+
+		return nil, err
+	}
+
+	rpcNodeHandler.recordSuccess(time.Since(start))
+
+	return &result, checkBroadcastResult(result.Result)
+}
+
+// checkBroadcastResult returns the (JSON) response as error if the tx was rejected, like the full-node `submit` mutation.
+func checkBroadcastResult(result interface{}) error {
+	switch res := result.(type) {
+	case *ctypes.ResultBroadcastTx:
+		if res.Code != 0 {
+			return fmt.Errorf(`{"code":%d,"log":%q}`, res.Code, res.Log)
+		}
+	case *ctypes.ResultBroadcastTxCommit:
+		if res.CheckTx.IsErr() {
+			errBytes, _ := res.CheckTx.MarshalJSON()
+			return errors.New(string(errBytes))
+		}
+
+		if res.DeliverTx.IsErr() {
+			errBytes, _ := res.DeliverTx.MarshalJSON()
+			return errors.New(string(errBytes))
+		}
+	}
+
+	return nil
+}
+
+// isNetworkError checks if the RPC call failed to reach the node (as opposed to the node returning an error).
+func isNetworkError(err error) bool {
+	_, ok := pkgErrors.Cause(err).(net.Error)
+	return ok
+}
+
+// isConnectionError checks if the RPC call failed to connect to the node, i.e. the request wasn't sent.
+func isConnectionError(err error) bool {
+	cause := pkgErrors.Cause(err)
+	if urlErr, ok := cause.(*url.Error); ok {
+		cause = urlErr.Err
+	}
+
+	switch cause := cause.(type) {
+	case *net.OpError:
+		return cause.Op == "dial"
+	case *net.DNSError:
+		return true
+	}
+
+	return false
+}
@@ -5,100 +5,230 @@
 package sync
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"time"
 
 	storeTypes "github.com/cosmos/cosmos-sdk/store/types"
+	pkgErrors "github.com/pkg/errors"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/wirelineio/wns/x/bond"
 	"github.com/wirelineio/wns/x/nameservice"
 )
 
 // getCurrentHeight gets the current WNS block height.
 func (rpcNodeHandler *RPCNodeHandler) getCurrentHeight() (int64, error) {
-	rpcNodeHandler.Calls++
-	rpcNodeHandler.LastCalledAt = time.Now().UTC()
+	start := rpcNodeHandler.recordCall()
 
 	// Note: Always get from primary node.
 	status, err := rpcNodeHandler.Client.Status()
 	if err != nil {
-		rpcNodeHandler.Errors++
+		rpcNodeHandler.recordError()
 		return 0, err
 	}
 
+	rpcNodeHandler.recordSuccess(time.Since(start))
+
 	return status.SyncInfo.LatestBlockHeight, nil
 }
 
-func (rpcNodeHandler *RPCNodeHandler) getBlockChangeset(ctx *Context, height int64) (*nameservice.BlockChangeset, error) {
-	value, err := rpcNodeHandler.getStoreValue(ctx, nameservice.GetBlockChangesetIndexKey(height), height)
+// getBlockChangeset gets the changeset at the given height (empty if none), along with the verified store value.
+func getBlockChangeset(ctx *Context, height int64) (*nameservice.BlockChangeset, storeValue, error) {
+	value, err := fetchStoreValue(ctx, nameservice.StoreKey, nameservice.GetBlockChangesetIndexKey(height), height)
 	if err != nil {
-		return nil, err
+		return nil, value, err
 	}
 
 	var changeset nameservice.BlockChangeset
-	ctx.codec.MustUnmarshalBinaryBare(value, &changeset)
+	ctx.codec.MustUnmarshalBinaryBare(value.value, &changeset)
+
+	return &changeset, value, nil
+}
 
-	return &changeset, nil
+// getBondChangeset gets the bonds changed at the given height (nil if none), along with the verified store value.
+func getBondChangeset(ctx *Context, height int64) (*bond.BlockChangeset, storeValue, error) {
+	value, err := fetchStoreValue(ctx, bond.StoreKey, bond.GetBlockChangesetIndexKey(height), height)
+	if err != nil || value.value == nil {
+		return nil, value, err
+	}
+
+	var changeset bond.BlockChangeset
+	ctx.codec.MustUnmarshalBinaryBare(value.value, &changeset)
+
+	return &changeset, value, nil
 }
 
-func (rpcNodeHandler *RPCNodeHandler) getStoreValue(ctx *Context, key []byte, height int64) ([]byte, error) {
+// getStoreValue gets a nameservice store value at the given height, along with its verified proof.
+func (rpcNodeHandler *RPCNodeHandler) getStoreValue(ctx *Context, key []byte, height int64) ([]byte, *Proof, error) {
+	return rpcNodeHandler.getModuleStoreValue(ctx, nameservice.StoreKey, key, height)
+}
+
+// getModuleStoreValue gets a module store value at the given height, along with its verified proof.
+func (rpcNodeHandler *RPCNodeHandler) getModuleStoreValue(ctx *Context, storeKey string, key []byte, height int64) ([]byte, *Proof, error) {
 	opts := rpcclient.ABCIQueryOptions{
 		Height: height,
 		Prove:  true,
 	}
 
-	path := "/store/nameservice/key"
+	path := fmt.Sprintf("/store/%s/key", storeKey)
 
-	rpcNodeHandler.Calls++
-	rpcNodeHandler.LastCalledAt = time.Now().UTC()
+	start := rpcNodeHandler.recordCall()
 
 	res, err := rpcNodeHandler.Client.ABCIQueryWithOptions(path, key, opts)
 	if err != nil {
-		rpcNodeHandler.Errors++
-		return nil, err
+		rpcNodeHandler.recordError()
+		return nil, nil, err
+	}
+
+	latency := time.Since(start)
+
+	// Lite peers only serve values they have proofs for at the height (see peer.go).
+	if res.Response.Codespace == PeerCodespace && res.Response.Code == CodeValueNotAvailable {
+		rpcNodeHandler.recordSuccess(latency)
+		return nil, nil, ErrValueNotAvailable
 	}
 
 	if res.Response.IsErr() {
-		rpcNodeHandler.Errors++
-		return nil, fmt.Errorf("error fetching state: %s", res.Response.GetLog())
+		rpcNodeHandler.recordError()
+		return nil, nil, fmt.Errorf("error fetching state: %s", res.Response.GetLog())
 	}
 
-	if res.Response.Height == 0 && res.Response.Value != nil {
-		rpcNodeHandler.Errors++
-		return nil, errors.New("invalid response height/value")
+	// Values and their absence must both be proven at the height asked for.
+	if res.Response.Height != height {
+		rpcNodeHandler.recordVerificationError()
+		return nil, nil, fmt.Errorf("invalid response height: %d", res.Response.Height)
 	}
 
-	if res.Response.Height > 0 && res.Response.Height != height {
-		rpcNodeHandler.Errors++
-		return nil, fmt.Errorf("invalid response height: %d", res.Response.Height)
+	// The proof is verified against the response key, which must be the key asked for.
+	if !bytes.Equal(res.Response.Key, key) {
+		rpcNodeHandler.recordVerificationError()
+		return nil, nil, fmt.Errorf("invalid response key: %X", res.Response.Key)
 	}
 
-	if res.Response.Height > 0 {
-		// Note: Fails with `panic: runtime error: invalid memory address or nil pointer dereference` if called with empty response.
-		err = VerifyProof(ctx, path, res.Response)
-		if err != nil {
-			return nil, err
+	appHash, err := VerifyProof(ctx, path, res.Response)
+	if err != nil {
+		// Failure to certify the commit isn't the node's fault (commits are fetched from the primary node).
+		if pkgErrors.Cause(err) == ErrInvalidProof {
+			rpcNodeHandler.recordVerificationError()
 		}
+
+		return nil, nil, err
 	}
 
-	return res.Response.Value, nil
+	rpcNodeHandler.recordSuccess(latency)
+
+	return res.Response.Value, &Proof{Height: res.Response.Height, AppHash: appHash, Proof: res.Response.Proof}, nil
 }
 
-func (ctx *Context) getStoreSubspace(subspace string, key []byte, height int64) ([]storeTypes.KVPair, error) {
+// getStoreSubspace gets the module store KV pairs with the key prefix at the given height (unverified, as subspace queries aren't provable).
+func (rpcNodeHandler *RPCNodeHandler) getStoreSubspace(ctx *Context, subspace string, key []byte, height int64) ([]storeTypes.KVPair, error) {
 	opts := rpcclient.ABCIQueryOptions{Height: height}
 	path := fmt.Sprintf("/store/%s/subspace", subspace)
 
-	ctx.primaryNode.Calls++
-	ctx.primaryNode.LastCalledAt = time.Now().UTC()
+	start := rpcNodeHandler.recordCall()
 
-	res, err := ctx.primaryNode.Client.ABCIQueryWithOptions(path, key, opts)
+	res, err := rpcNodeHandler.Client.ABCIQueryWithOptions(path, key, opts)
 	if err != nil {
-		ctx.primaryNode.Errors++
+		rpcNodeHandler.recordError()
 		return nil, err
 	}
 
+	if res.Response.IsErr() {
+		rpcNodeHandler.recordError()
+		return nil, fmt.Errorf("error fetching state: %s", res.Response.GetLog())
+	}
+
+	rpcNodeHandler.recordSuccess(time.Since(start))
+
 	var KVs []storeTypes.KVPair
 	ctx.codec.MustUnmarshalBinaryLengthPrefixed(res.Response.Value, &KVs)
 
 	return KVs, nil
 }
+
+// getSubspaceKeys lists the module store keys with the prefix at the given height from the primary node,
+// cross-checked against the listing of every other RPC node (see Config.CrossCheckNodes).
+// Note: Subspace queries aren't provable, so keys omitted by all nodes can't be detected.
+func (ctx *Context) getSubspaceKeys(storeKey string, prefix []byte, height int64) ([][]byte, error) {
+	primaryNode := getPrimaryNode(ctx)
+
+	ctx.nodeLock.RLock()
+	nodes := []*RPCNodeHandler{primaryNode}
+	for _, node := range ctx.secondaryNodes {
+		if node != primaryNode {
+			nodes = append(nodes, node)
+		}
+	}
+	ctx.nodeLock.RUnlock()
+
+	if len(nodes) < 2 {
+		return nil, errors.New("no RPC node to cross-check subspace keys against")
+	}
+
+	var keys [][]byte
+	for index, node := range nodes {
+		KVs, err := node.getStoreSubspace(ctx, storeKey, prefix, height)
+		if err != nil {
+			return nil, err
+		}
+
+		nodeKeys := make([][]byte, len(KVs))
+		for kvIndex, kv := range KVs {
+			if !bytes.HasPrefix(kv.Key, prefix) {
+				return nil, fmt.Errorf("unexpected key in subspace from %s: %X", node.Address, kv.Key)
+			}
+
+			nodeKeys[kvIndex] = kv.Key
+		}
+
+		if index == 0 {
+			keys = nodeKeys
+			continue
+		}
+
+		// Subspace KV pairs are listed in key order.
+		if len(nodeKeys) != len(keys) {
+			return nil, fmt.Errorf("subspace key count mismatch between %s (%d) and %s (%d)", primaryNode.Address, len(keys), node.Address, len(nodeKeys))
+		}
+
+		for kvIndex := range keys {
+			if !bytes.Equal(keys[kvIndex], nodeKeys[kvIndex]) {
+				return nil, fmt.Errorf("subspace key mismatch between %s (%X) and %s (%X)", primaryNode.Address, keys[kvIndex], node.Address, nodeKeys[kvIndex])
+			}
+		}
+	}
+
+	return keys, nil
+}
+
+// getVerifiedSubspace gets the module store KV pairs with the key prefix at the given height,
+// with each value verified against the certified app hash (returned along with the proofs).
+// Only verified KV pairs for which keep returns true are returned.
+func (ctx *Context) getVerifiedSubspace(storeKey string, prefix []byte, height int64, keep func(storeTypes.KVPair) bool) ([]storeTypes.KVPair, []*Proof, error) {
+	keys, err := ctx.getSubspaceKeys(storeKey, prefix, height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values, err := fetchStoreValues(ctx, storeKey, keys, height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	KVs := []storeTypes.KVPair{}
+	proofs := []*Proof{}
+	for _, value := range values {
+		if value.proof == nil || value.value == nil {
+			return nil, nil, fmt.Errorf("key not found in verified state: %X", value.key)
+		}
+
+		kv := storeTypes.KVPair{Key: value.key, Value: value.value}
+		if keep(kv) {
+			KVs = append(KVs, kv)
+			proofs = append(proofs, value.proof)
+		}
+	}
+
+	return KVs, proofs, nil
+}
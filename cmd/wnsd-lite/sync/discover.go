@@ -5,21 +5,66 @@
 package sync
 
 import (
+	"bufio"
 	"context"
-
 	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/machinebox/graphql"
 	"github.com/wirelineio/wns/gql"
 )
 
-const query = `
-{
-	records: queryRecords(attributes: [{ key: "type", value: { string: "wrn:kube" } }]) {
+// DefaultDiscoveryRecordType is the default type of WNS records with RPC endpoints.
+const DefaultDiscoveryRecordType = "wrn:kube"
+
+// DefaultDiscoveryAttributePath is the default path (attribute key, followed by JSON fields) of the RPC endpoint in the record.
+const DefaultDiscoveryAttributePath = "wns.rpc"
+
+// Discoverer is a source of RPC endpoints.
+type Discoverer interface {
+	// Name identifies the discoverer (in logs).
+	Name() string
+
+	// Discover returns the current set of RPC endpoints.
+	// On error, the endpoints discovered earlier are kept.
+	Discover(ctx *Context) ([]string, error)
+}
+
+// NewDiscoverers creates the discoverers enabled in the config.
+func NewDiscoverers(config *Config) []Discoverer {
+	discoverers := []Discoverer{}
+
+	if config.Endpoint != "" {
+		discoverers = append(discoverers, &RecordDiscoverer{
+			Endpoint:      config.Endpoint,
+			RecordType:    config.DiscoveryRecordType,
+			AttributePath: config.DiscoveryAttributePath,
+		})
+	}
+
+	if config.EndpointsFile != "" {
+		discoverers = append(discoverers, &FileDiscoverer{Path: config.EndpointsFile})
+	}
+
+	if config.DiscoveryDNSSRV != "" {
+		discoverers = append(discoverers, &DNSSRVDiscoverer{Service: config.DiscoveryDNSSRV})
+	}
+
+	return discoverers
+}
+
+const recordQuery = `
+query ($type: String!) {
+	records: queryRecords(attributes: [{ key: "type", value: { string: $type } }]) {
 		id
 		attributes {
 			key
 			value {
+				string
 				json
 			}
 		}
@@ -32,37 +77,170 @@ type Response struct {
 	Records []gql.Record `json:"records"`
 }
 
-// DiscoverRPCEndpoints queries for WNS RPC endpoints.
-func DiscoverRPCEndpoints(ctx *Context, gqlEndpoint string) ([]string, error) {
-	client := graphql.NewClient(gqlEndpoint)
-	req := graphql.NewRequest(query)
+// RecordDiscoverer discovers RPC endpoints from WNS records, e.g. `kube` records with a `wns.rpc` field.
+type RecordDiscoverer struct {
+	// WNS GQL endpoint.
+	Endpoint string
+
+	RecordType string
+
+	// Attribute key, followed by the (dot separated) fields of its JSON value, if any.
+	AttributePath string
+}
+
+// Name returns the discoverer name.
+func (discoverer *RecordDiscoverer) Name() string {
+	return fmt.Sprintf("records(%s, %s, %s)", discoverer.Endpoint, discoverer.RecordType, discoverer.AttributePath)
+}
+
+// Discover queries for records of the type, and returns the RPC endpoints at the attribute path.
+func (discoverer *RecordDiscoverer) Discover(ctx *Context) ([]string, error) {
+	client := graphql.NewClient(discoverer.Endpoint)
+	req := graphql.NewRequest(recordQuery)
+	req.Var("type", discoverer.RecordType)
 	req.Header.Set("Cache-Control", "no-cache")
 	gqlContext := context.Background()
 
 	var response Response
 	if err := client.Run(gqlContext, req, &response); err != nil {
-		ctx.log.Errorln(err)
 		return nil, err
 	}
 
-	rpcEndpoints := []string{}
+	path := strings.Split(discoverer.AttributePath, ".")
 
+	rpcEndpoints := []string{}
 	for _, record := range response.Records {
 		for _, kv := range record.Attributes {
-			if kv.Key == "wns" {
-				var data map[string]interface{}
-				err := json.Unmarshal([]byte(*kv.Value.JSON), &data)
-				if err != nil {
-					return nil, err
-				}
-
-				if server, ok := data["rpc"].(string); ok {
-					if server != "" {
-						rpcEndpoints = append(rpcEndpoints, server)
-					}
-				}
+			if kv.Key != path[0] {
+				continue
+			}
+
+			server, err := getAttributeValue(kv.Value, path[1:])
+			if err != nil {
+				// Skip badly formed records, rather than failing discovery.
+				ctx.log.Errorln("Error reading RPC endpoint from record", record.ID, err)
+				continue
 			}
+
+			if server != "" {
+				rpcEndpoints = append(rpcEndpoints, server)
+			}
+		}
+	}
+
+	return rpcEndpoints, nil
+}
+
+// getAttributeValue gets the string at the path (of JSON fields) in the attribute value.
+func getAttributeValue(value gql.Value, path []string) (string, error) {
+	if len(path) == 0 {
+		if value.String == nil {
+			return "", nil
+		}
+
+		return *value.String, nil
+	}
+
+	if value.JSON == nil {
+		return "", nil
+	}
+
+	var data interface{}
+	err := json.Unmarshal([]byte(*value.JSON), &data)
+	if err != nil {
+		return "", err
+	}
+
+	for _, field := range path {
+		object, ok := data.(map[string]interface{})
+		if !ok {
+			return "", nil
+		}
+
+		data = object[field]
+	}
+
+	server, _ := data.(string)
+
+	return server, nil
+}
+
+// FileDiscoverer reads RPC endpoints from a file (one per line, `#` for comments), re-reading it when it changes.
+type FileDiscoverer struct {
+	Path string
+
+	modTime   time.Time
+	size      int64
+	endpoints []string
+}
+
+// Name returns the discoverer name.
+func (discoverer *FileDiscoverer) Name() string {
+	return fmt.Sprintf("file(%s)", discoverer.Path)
+}
+
+// Discover returns the endpoints in the file, which is only read if it has changed since it was last read.
+func (discoverer *FileDiscoverer) Discover(ctx *Context) ([]string, error) {
+	info, err := os.Stat(discoverer.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	if discoverer.endpoints != nil && info.ModTime().Equal(discoverer.modTime) && info.Size() == discoverer.size {
+		return discoverer.endpoints, nil
+	}
+
+	file, err := os.Open(discoverer.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	endpoints := []string{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(strings.SplitN(scanner.Text(), "#", 2)[0])
+		if line != "" {
+			endpoints = append(endpoints, line)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	ctx.log.Infoln("Read RPC endpoints file:", discoverer.Path)
+
+	discoverer.modTime, discoverer.size, discoverer.endpoints = info.ModTime(), info.Size(), endpoints
+
+	return endpoints, nil
+}
+
+// DNSSRVDiscoverer looks up RPC endpoints using DNS SRV records, e.g. `_wns-rpc._tcp.example.com`.
+type DNSSRVDiscoverer struct {
+	Service string
+}
+
+// Name returns the discoverer name.
+func (discoverer *DNSSRVDiscoverer) Name() string {
+	return fmt.Sprintf("dns-srv(%s)", discoverer.Service)
+}
+
+// Discover looks up the SRV records, and returns their targets as RPC endpoints.
+func (discoverer *DNSSRVDiscoverer) Discover(ctx *Context) ([]string, error) {
+	_, addrs, err := net.LookupSRV("", "", discoverer.Service)
+	if err != nil {
+		// No records (as opposed to a failed lookup) means no endpoints.
+		if dnsErr, ok := err.(*net.DNSError); ok && dnsErr.IsNotFound {
+			return []string{}, nil
 		}
+
+		return nil, err
+	}
+
+	rpcEndpoints := []string{}
+	for _, addr := range addrs {
+		rpcEndpoints = append(rpcEndpoints, fmt.Sprintf("tcp://%s:%d", strings.TrimSuffix(addr.Target, "."), addr.Port))
 	}
 
 	return rpcEndpoints, nil
@@ -0,0 +1,150 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package sync
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	pkgErrors "github.com/pkg/errors"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+// The lite node diverges from the primary node if the primary reports a height lower than the last synced height
+// (e.g. it's on a fork, or its chain was reset) or serves commits that fail verification against the trust store.
+// Persistent divergence (DivergenceErrorThreshold consecutive sync errors) quarantines the primary node, and fails
+// over to a secondary node that has caught up to the last synced height.
+//
+// If there's no such node, or values keep failing verification (from all nodes), the node can't recover by itself.
+// It must be stopped, rolled back (see undo.go) and restarted with a trusted node.
+
+// DivergenceErrorThreshold is the number of consecutive divergence errors after which the primary node is replaced.
+const DivergenceErrorThreshold = 5
+
+// ErrPrimaryNodeBehind is returned if the primary node height is lower than the last synced height.
+var ErrPrimaryNodeBehind = errors.New("last synced height greater than current chain height")
+
+// ErrInvalidCommit is returned if the commit from the primary node fails verification against the trust store.
+var ErrInvalidCommit = errors.New("failed to verify commit")
+
+// isDivergenceError checks if the sync error indicates divergence from the primary node (or the chain).
+func isDivergenceError(err error) bool {
+	cause := pkgErrors.Cause(err)
+
+	return cause == ErrPrimaryNodeBehind || cause == ErrInvalidCommit || cause == ErrInvalidProof
+}
+
+// handleDivergence counts consecutive divergence errors, and on persistent divergence replaces the primary node
+// (if it's at fault), or logs how to recover manually.
+func handleDivergence(ctx *Context, primaryNode *RPCNodeHandler, lastSyncedHeight int64, err error) {
+	if !isDivergenceError(err) {
+		return
+	}
+
+	ctx.divergenceErrors++
+	if ctx.divergenceErrors < DivergenceErrorThreshold {
+		return
+	}
+
+	ctx.divergenceErrors = 0
+
+	// Proofs are verified against commits from the primary node, but are served by all nodes.
+	if pkgErrors.Cause(err) != ErrInvalidProof {
+		ctx.log.Warnln("Persistent divergence from primary node, quarantining:", primaryNode.Address, err)
+		primaryNode.recordVerificationError()
+
+		if failoverPrimaryNode(ctx, primaryNode, lastSyncedHeight) {
+			return
+		}
+	}
+
+	ctx.log.Errorln("Persistent divergence at height:", lastSyncedHeight, err)
+	ctx.log.Errorln("To recover, stop the node, run `wnsd-lite rollback --height <HEIGHT>` to rewind to a height before the divergence,",
+		"then restart it with a trusted `--node`.")
+}
+
+// failoverPrimaryNode makes the best scoring secondary node (that isn't quarantined, and has caught up to the
+// last synced height) the primary node. Returns false if there's no such node.
+func failoverPrimaryNode(ctx *Context, primaryNode *RPCNodeHandler, lastSyncedHeight int64) bool {
+	now := time.Now().UTC()
+
+	ctx.nodeLock.RLock()
+	candidates := []*RPCNodeHandler{}
+	scores := make(map[*RPCNodeHandler]float64)
+	for _, node := range ctx.secondaryNodes {
+		stats := node.Stats()
+		if node != primaryNode && !now.Before(stats.QuarantinedUntil) {
+			candidates = append(candidates, node)
+			scores[node] = stats.Score
+		}
+	}
+	ctx.nodeLock.RUnlock()
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return scores[candidates[i]] > scores[candidates[j]]
+	})
+
+	for _, node := range candidates {
+		height, err := node.getCurrentHeight()
+		if err != nil || height < lastSyncedHeight {
+			continue
+		}
+
+		ctx.nodeLock.Lock()
+		ctx.primaryNode = node
+		ctx.nodeLock.Unlock()
+
+		ctx.log.Warnln("Primary node failed over to:", node.Address)
+
+		return true
+	}
+
+	ctx.log.Errorln("No secondary node available to replace primary node:", primaryNode.Address)
+
+	return false
+}
+
+// getPrimaryNode returns the primary node, which can change on failover.
+func getPrimaryNode(ctx *Context) *RPCNodeHandler {
+	ctx.nodeLock.RLock()
+	defer ctx.nodeLock.RUnlock()
+
+	return ctx.primaryNode
+}
+
+// primaryClient is the verifier source, which calls the current primary node.
+// The verifier is created once (its trust store can't be reopened), so it must follow primary node failover.
+type primaryClient struct {
+	ctx *Context
+}
+
+func (client primaryClient) Block(height *int64) (*ctypes.ResultBlock, error) {
+	return getPrimaryNode(client.ctx).Client.Block(height)
+}
+
+func (client primaryClient) BlockResults(height *int64) (*ctypes.ResultBlockResults, error) {
+	return getPrimaryNode(client.ctx).Client.BlockResults(height)
+}
+
+func (client primaryClient) Commit(height *int64) (*ctypes.ResultCommit, error) {
+	return getPrimaryNode(client.ctx).Client.Commit(height)
+}
+
+func (client primaryClient) Validators(height *int64) (*ctypes.ResultValidators, error) {
+	return getPrimaryNode(client.ctx).Client.Validators(height)
+}
+
+func (client primaryClient) Tx(hash []byte, prove bool) (*ctypes.ResultTx, error) {
+	return getPrimaryNode(client.ctx).Client.Tx(hash, prove)
+}
+
+func (client primaryClient) TxSearch(query string, prove bool, page, perPage int) (*ctypes.ResultTxSearch, error) {
+	return getPrimaryNode(client.ctx).Client.TxSearch(query, prove, page, perPage)
+}
+
+func (client primaryClient) Status() (*ctypes.ResultStatus, error) {
+	return getPrimaryNode(client.ctx).Client.Status()
+}
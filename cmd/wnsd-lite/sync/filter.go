@@ -0,0 +1,138 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package sync
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	ns "github.com/wirelineio/wns/x/nameservice"
+)
+
+// AttributePredicate matches records with the given attribute value.
+type AttributePredicate struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// SyncFilter restricts the state synced by the lite node (partial sync).
+// Authority records and names are kept if they are under one of the authorities or match one of the WRN prefixes.
+// Records are kept if they match all the attribute predicates (if any), or are referenced by a kept name.
+type SyncFilter struct {
+	Authorities      []string             `json:"authorities,omitempty"`
+	NamePrefixes     []string             `json:"namePrefixes,omitempty"`
+	RecordAttributes []AttributePredicate `json:"recordAttributes,omitempty"`
+}
+
+// NewSyncFilter creates a sync filter from the flag values, with record attribute predicates as `key=value` strings.
+// Returns nil (i.e. full sync) if no filters are passed.
+func NewSyncFilter(authorities []string, namePrefixes []string, recordAttributes []string) (*SyncFilter, error) {
+	filter := SyncFilter{Authorities: authorities, NamePrefixes: namePrefixes}
+
+	for _, prefix := range namePrefixes {
+		if getAuthorityName(prefix) == "" {
+			return nil, fmt.Errorf("invalid WRN prefix: %s", prefix)
+		}
+	}
+
+	for _, predicate := range recordAttributes {
+		parts := strings.SplitN(predicate, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid record attribute predicate: %s", predicate)
+		}
+
+		filter.RecordAttributes = append(filter.RecordAttributes, AttributePredicate{Key: parts[0], Value: parts[1]})
+	}
+
+	if filter.IsEmpty() {
+		return nil, nil
+	}
+
+	return &filter, nil
+}
+
+// IsEmpty returns true if the filter doesn't restrict anything, i.e. full sync.
+func (filter *SyncFilter) IsEmpty() bool {
+	return filter == nil || (len(filter.Authorities) == 0 && len(filter.NamePrefixes) == 0 && len(filter.RecordAttributes) == 0)
+}
+
+// KeepAuthority checks if the authority record should be synced.
+func (filter *SyncFilter) KeepAuthority(name string) bool {
+	if filter.IsEmpty() || filter.underAuthority(name) {
+		return true
+	}
+
+	// Authorities of the WRN prefixes are needed to resolve names under them.
+	for _, prefix := range filter.NamePrefixes {
+		if getAuthorityName(prefix) == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// KeepName checks if the name record should be synced.
+func (filter *SyncFilter) KeepName(wrn string) bool {
+	if filter.IsEmpty() || filter.underAuthority(getAuthorityName(wrn)) {
+		return true
+	}
+
+	for _, prefix := range filter.NamePrefixes {
+		if strings.HasPrefix(wrn, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// KeepRecord checks if the record should be synced based on its attributes.
+// Note: Records referenced by kept names are synced irrespective of their attributes.
+func (filter *SyncFilter) KeepRecord(record ns.Record) bool {
+	if filter.IsEmpty() {
+		return true
+	}
+
+	if len(filter.RecordAttributes) == 0 {
+		return false
+	}
+
+	for _, predicate := range filter.RecordAttributes {
+		value, ok := record.Attributes[predicate.Key]
+		if !ok || fmt.Sprint(value) != predicate.Value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// underAuthority checks if the authority is one of the filter authorities, or a sub-authority of one.
+func (filter *SyncFilter) underAuthority(name string) bool {
+	for _, authority := range filter.Authorities {
+		if name == authority || strings.HasSuffix(name, "."+authority) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getAuthorityName gets the authority name of a WRN (or WRN prefix).
+func getAuthorityName(wrn string) string {
+	parsedWRN, err := url.Parse(wrn)
+	if err != nil || parsedWRN.Scheme != "wrn" {
+		return ""
+	}
+
+	return parsedWRN.Host
+}
+
+// GetSyncFilter gets the sync filter (nil if the node isn't partially synced).
+func (ctx *Context) GetSyncFilter() *SyncFilter {
+	return ctx.config.SyncFilter
+}
@@ -5,8 +5,15 @@
 package sync
 
 import (
+	"fmt"
+	"sync"
+
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/wirelineio/wns/x/bond"
 	ns "github.com/wirelineio/wns/x/nameservice"
 )
 
@@ -15,11 +22,14 @@ type Keeper struct {
 	config *Config
 	codec  *amino.Codec
 	store  store.KVStore
+
+	// Context store lock, so that a value and its proof are read at the same height.
+	storeLock *sync.RWMutex
 }
 
 // NewKeeper creates a new keeper.
 func NewKeeper(ctx *Context) *Keeper {
-	return &Keeper{config: ctx.config, codec: ctx.codec, store: ctx.store}
+	return &Keeper{config: ctx.config, codec: ctx.codec, store: ctx.store, storeLock: &ctx.storeLock}
 }
 
 // Status represents the sync status of the node.
@@ -28,6 +38,18 @@ type Status struct {
 	CatchingUp       bool
 }
 
+// Proof is a verified merkle proof of a synced store value.
+type Proof struct {
+	Height  int64
+	AppHash []byte
+	Proof   *merkle.Proof
+}
+
+// GetProofIndexKey generates the store key -> Proof index key.
+func GetProofIndexKey(key []byte) []byte {
+	return append(append([]byte{}, ns.PrefixStoreKeyToProofIndex...), key...)
+}
+
 // GetChainID gets the chain ID.
 func (k Keeper) GetChainID() string {
 	return k.config.ChainID
@@ -53,6 +75,25 @@ func (k Keeper) SaveStatus(status Status) {
 	k.store.Set(ns.KeySyncStatus, bz)
 }
 
+// GetSyncFilter gets the sync filter the node was initialized with (nil if fully synced).
+func (k Keeper) GetSyncFilter() *SyncFilter {
+	bz := k.store.Get(ns.KeySyncFilter)
+	if bz == nil {
+		return nil
+	}
+
+	var filter SyncFilter
+	k.codec.MustUnmarshalBinaryBare(bz, &filter)
+
+	return &filter
+}
+
+// SaveSyncFilter saves the sync filter.
+func (k Keeper) SaveSyncFilter(filter SyncFilter) {
+	bz := k.codec.MustMarshalBinaryBare(filter)
+	k.store.Set(ns.KeySyncFilter, bz)
+}
+
 // HasRecord - checks if a record by the given ID exists.
 func (k Keeper) HasRecord(id ns.ID) bool {
 	return ns.HasRecord(k.store, id)
@@ -63,9 +104,15 @@ func (k Keeper) GetRecord(id ns.ID) ns.Record {
 	return ns.GetRecord(k.store, k.codec, id)
 }
 
+// GetRecordVersions gets the version chain (oldest first) of a record.
+func (k Keeper) GetRecordVersions(id ns.ID) []ns.Record {
+	return ns.GetRecordVersions(k.store, k.codec, id)
+}
+
 // PutRecord - saves a record to the store and updates ID -> Record index.
 func (k Keeper) PutRecord(record ns.RecordObj) {
 	k.store.Set(ns.GetRecordIndexKey(record.ID), k.codec.MustMarshalBinaryBare(record))
+	ns.AddRecordToAttributeIndex(k.store, record.ToRecord())
 }
 
 // SetNameAuthorityRecord - sets a name authority record.
@@ -83,6 +130,82 @@ func (k Keeper) SetNameRecord(wrn string, nameRecord ns.NameRecord) {
 	ns.SetNameRecord(k.store, k.codec, wrn, nameRecord.ID, nameRecord.Height)
 }
 
+// GetProof gets the proof of the synced store value (nil if not synced, e.g. imported during init), along with the value.
+func (k Keeper) GetProof(key []byte) (*Proof, []byte) {
+	k.storeLock.RLock()
+	defer k.storeLock.RUnlock()
+
+	return getProof(k.store, k.codec, key)
+}
+
+// getProof gets the proof of the synced store value (nil if not synced), along with the value.
+func getProof(kvStore store.KVStore, codec *amino.Codec, key []byte) (*Proof, []byte) {
+	bz := kvStore.Get(GetProofIndexKey(key))
+	if bz == nil {
+		return nil, nil
+	}
+
+	var proof Proof
+	codec.MustUnmarshalBinaryBare(bz, &proof)
+
+	return &proof, kvStore.Get(key)
+}
+
+// SetProof saves the proof of a synced store value.
+func (k Keeper) SetProof(key []byte, proof Proof) {
+	k.store.Set(GetProofIndexKey(key), k.codec.MustMarshalBinaryBare(proof))
+}
+
+// bondStore is the prefixed store for synced bonds, as bond keys would otherwise conflict with nameservice keys.
+func (k Keeper) bondStore() store.KVStore {
+	return prefix.NewStore(k.store, ns.PrefixBondStore)
+}
+
+// getModuleStore returns the store with the synced values of the module store.
+func getModuleStore(kvStore store.KVStore, storeName string) (store.KVStore, error) {
+	switch storeName {
+	case ns.StoreKey:
+		return kvStore, nil
+	case bond.StoreKey:
+		return prefix.NewStore(kvStore, ns.PrefixBondStore), nil
+	default:
+		return nil, fmt.Errorf("unsupported store: %s", storeName)
+	}
+}
+
+// HasBond - checks if a bond by the given ID exists.
+func (k Keeper) HasBond(id bond.ID) bool {
+	return bond.HasBond(k.bondStore(), id)
+}
+
+// GetBond - gets a bond from the store.
+func (k Keeper) GetBond(id bond.ID) bond.Bond {
+	return bond.GetBondByID(k.bondStore(), k.codec, id)
+}
+
+// HasBonds checks if the store has any bonds.
+func (k Keeper) HasBonds() bool {
+	itr := sdk.KVStorePrefixIterator(k.bondStore(), bond.PrefixIDToBondIndex)
+	defer itr.Close()
+
+	return itr.Valid()
+}
+
+// SaveBond - saves a bond to the store.
+func (k Keeper) SaveBond(bondObj bond.Bond) {
+	bond.SaveBond(k.bondStore(), k.codec, bondObj)
+}
+
+// SetBondProof saves the proof of a synced bond store value.
+func (k Keeper) SetBondProof(key []byte, proof Proof) {
+	k.bondStore().Set(GetProofIndexKey(key), k.codec.MustMarshalBinaryBare(proof))
+}
+
+// MatchBonds - get a page of matching bonds, starting at the cursor.
+func (k Keeper) MatchBonds(cursor string, limit int, matchFn func(*bond.Bond) bool) ([]*bond.Bond, string, error) {
+	return bond.MatchBonds(k.bondStore(), k.codec, cursor, limit, matchFn)
+}
+
 // ResolveWRN resolves a WRN to a record.
 func (k Keeper) ResolveWRN(wrn string) *ns.Record {
 	return ns.ResolveWRN(k.store, k.codec, wrn)
@@ -98,7 +221,75 @@ func (k Keeper) GetNameRecord(name string) *ns.NameRecord {
 	return ns.GetNameRecord(k.store, k.codec, name)
 }
 
-// MatchRecords - get all matching records.
-func (k Keeper) MatchRecords(matchFn func(*ns.Record) bool) []*ns.Record {
-	return ns.MatchRecords(k.store, k.codec, matchFn)
+// MatchRecords - get a page of matching records, starting at the cursor.
+func (k Keeper) MatchRecords(attributes map[string]interface{}, cursor string, limit int, matchFn func(*ns.Record) bool) ([]*ns.Record, string, error) {
+	return ns.MatchRecords(k.store, k.codec, attributes, cursor, limit, matchFn)
+}
+
+// HasAttributeIndex checks if the record attribute index has been built.
+// Always true for an empty store, as there is nothing to index.
+func (k Keeper) HasAttributeIndex() bool {
+	recordItr := sdk.KVStorePrefixIterator(k.store, ns.PrefixCIDToRecordIndex)
+	defer recordItr.Close()
+	if !recordItr.Valid() {
+		return true
+	}
+
+	indexItr := sdk.KVStorePrefixIterator(k.store, ns.PrefixAttributeToRecordsIndex)
+	defer indexItr.Close()
+
+	return indexItr.Valid()
+}
+
+// BuildAttributeIndex (re)builds the record attribute index from the records in the store.
+func (k Keeper) BuildAttributeIndex() {
+	var records []ns.Record
+
+	itr := sdk.KVStorePrefixIterator(k.store, ns.PrefixCIDToRecordIndex)
+	for ; itr.Valid(); itr.Next() {
+		var record ns.RecordObj
+		k.codec.MustUnmarshalBinaryBare(itr.Value(), &record)
+		records = append(records, record.ToRecord())
+	}
+	itr.Close()
+
+	for _, record := range records {
+		ns.AddRecordToAttributeIndex(k.store, record)
+	}
+}
+
+// BuildNameIndex (re)builds the record ID -> names index from the name records in the store.
+func (k Keeper) BuildNameIndex() {
+	nameRecords := make(map[string]ns.NameRecord)
+
+	itr := sdk.KVStorePrefixIterator(k.store, ns.PrefixWRNToNameRecordIndex)
+	for ; itr.Valid(); itr.Next() {
+		var nameRecord ns.NameRecord
+		k.codec.MustUnmarshalBinaryBare(itr.Value(), &nameRecord)
+		nameRecords[string(itr.Key()[len(ns.PrefixWRNToNameRecordIndex):])] = nameRecord
+	}
+	itr.Close()
+
+	for wrn, nameRecord := range nameRecords {
+		if nameRecord.ID != "" {
+			ns.AddRecordToNameMapping(k.store, k.codec, nameRecord.ID, wrn)
+		}
+	}
+}
+
+// BuildBondOwnerIndex (re)builds the bond owner index from the bonds in the store.
+func (k Keeper) BuildBondOwnerIndex() {
+	var bonds []bond.Bond
+
+	itr := sdk.KVStorePrefixIterator(k.bondStore(), bond.PrefixIDToBondIndex)
+	for ; itr.Valid(); itr.Next() {
+		var bondObj bond.Bond
+		k.codec.MustUnmarshalBinaryBare(itr.Value(), &bondObj)
+		bonds = append(bonds, bondObj)
+	}
+	itr.Close()
+
+	for _, bondObj := range bonds {
+		k.SaveBond(bondObj)
+	}
 }
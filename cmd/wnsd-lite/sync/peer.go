@@ -0,0 +1,97 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package sync
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/tendermint/go-amino"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpcserver "github.com/tendermint/tendermint/rpc/lib/server"
+	rpctypes "github.com/tendermint/tendermint/rpc/lib/types"
+)
+
+// Lite nodes serve the values they've synced (and verified) to other lite nodes, with the proofs they received,
+// using the `abci_query` Tendermint RPC method. So lite peers are called using the same RPC client as full-nodes,
+// and their responses are verified against the certified app hash in the same way.
+//
+// A value is only served at the height of its proof, i.e. the height it was last synced at. Block changesets
+// (and their proofs) are kept for the last ChangesetRetentionHeights heights, so that peers can sync recent heights.
+
+// ChangesetRetentionHeights is the number of recent heights for which block changesets are kept, to serve lite peers.
+const ChangesetRetentionHeights = 10000
+
+// PeerCodespace is the ABCI response codespace for lite peer errors.
+const PeerCodespace = "wnslite"
+
+// CodeValueNotAvailable is the ABCI response code if the lite peer doesn't have the value (with proof) at the height.
+const CodeValueNotAvailable uint32 = 1
+
+// ErrValueNotAvailable is returned if the lite peer doesn't have the value (with proof) at the height.
+var ErrValueNotAvailable = errors.New("value not available from peer")
+
+// servePeers serves the synced values (with proofs) to lite peers.
+func servePeers(ctx *Context) {
+	codec := amino.NewCodec()
+	ctypes.RegisterAmino(codec)
+
+	routes := map[string]*rpcserver.RPCFunc{
+		"abci_query": rpcserver.NewRPCFunc(ctx.abciQuery, "path,data,height,prove"),
+	}
+
+	mux := http.NewServeMux()
+	rpcserver.RegisterRPCFuncs(mux, routes, codec, log.NewNopLogger())
+
+	config := rpcserver.DefaultConfig()
+	listener, err := rpcserver.Listen(ctx.config.PeerListenAddress, config)
+	if err != nil {
+		ctx.log.Fatalln("Error serving lite peers:", err)
+	}
+
+	err = rpcserver.StartHTTPServer(listener, mux, log.NewNopLogger(), config)
+	if err != nil {
+		ctx.log.Fatalln("Error serving lite peers:", err)
+	}
+}
+
+// abciQuery serves a synced store value, along with its proof, if the proof is at the given height.
+func (ctx *Context) abciQuery(_ *rpctypes.Context, path string, data cmn.HexBytes, height int64, prove bool) (*ctypes.ResultABCIQuery, error) {
+	storeName, err := parseQueryStorePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	kvStore, err := getModuleStore(ctx.store, storeName)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.storeLock.RLock()
+	proof, value := getProof(kvStore, ctx.codec, data)
+	ctx.storeLock.RUnlock()
+
+	if proof == nil || proof.Height != height {
+		ctx.log.Debugln("Value not available for peer, key:", data, "height:", height)
+
+		return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{
+			Codespace: PeerCodespace,
+			Code:      CodeValueNotAvailable,
+			Log:       fmt.Sprintf("value not available at height %d", height),
+			Key:       data,
+		}}, nil
+	}
+
+	return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{
+		Key:    data,
+		Value:  value,
+		Proof:  proof.Proof,
+		Height: proof.Height,
+	}}, nil
+}
@@ -0,0 +1,246 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package sync
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+// StatsSmoothingFactor is the weight of the latest call in the error rate and latency moving averages.
+const StatsSmoothingFactor = 0.1
+
+// QuarantineErrorThreshold is the number of consecutive errors after which a node is quarantined.
+const QuarantineErrorThreshold = 3
+
+// QuarantineBaseDuration is the duration of the first quarantine, doubled for every subsequent quarantine.
+const QuarantineBaseDuration = 30 * time.Second
+
+// QuarantineMaxDuration is the max. duration of a quarantine.
+const QuarantineMaxDuration = 30 * time.Minute
+
+// MaxErrorWaitDurationMillis is the max. wait duration (with backoff) in case of sync errors.
+const MaxErrorWaitDurationMillis = 60 * 1000
+
+// RPCNodeStats are the stats and QoS score of an RPC node.
+type RPCNodeStats struct {
+	Address            string        `json:"address"`
+	Calls              int64         `json:"calls"`
+	Errors             int64         `json:"errors"`
+	VerificationErrors int64         `json:"verificationErrors"`
+	ErrorRate          float64       `json:"errorRate"`
+	Latency            time.Duration `json:"latency"`
+	Score              float64       `json:"score"`
+	QuarantinedUntil   time.Time     `json:"quarantinedUntil"`
+	LastCalledAt       time.Time     `json:"lastCalledAt"`
+}
+
+// recordCall records the start of a call, and returns the start time (to measure latency).
+func (rpcNodeHandler *RPCNodeHandler) recordCall() time.Time {
+	rpcNodeHandler.statsLock.Lock()
+	defer rpcNodeHandler.statsLock.Unlock()
+
+	now := time.Now().UTC()
+	rpcNodeHandler.Calls++
+	rpcNodeHandler.LastCalledAt = now
+
+	return now
+}
+
+// recordSuccess records a successful call.
+func (rpcNodeHandler *RPCNodeHandler) recordSuccess(latency time.Duration) {
+	rpcNodeHandler.statsLock.Lock()
+	defer rpcNodeHandler.statsLock.Unlock()
+
+	if rpcNodeHandler.Latency == 0 {
+		rpcNodeHandler.Latency = latency
+	} else {
+		rpcNodeHandler.Latency = time.Duration((1-StatsSmoothingFactor)*float64(rpcNodeHandler.Latency) + StatsSmoothingFactor*float64(latency))
+	}
+
+	rpcNodeHandler.ErrorRate = (1 - StatsSmoothingFactor) * rpcNodeHandler.ErrorRate
+	rpcNodeHandler.ConsecutiveErrors = 0
+	rpcNodeHandler.Quarantines = 0
+}
+
+// recordError records a failed call (e.g. network error), and quarantines the node if it keeps failing.
+func (rpcNodeHandler *RPCNodeHandler) recordError() {
+	rpcNodeHandler.statsLock.Lock()
+	defer rpcNodeHandler.statsLock.Unlock()
+
+	rpcNodeHandler.Errors++
+	rpcNodeHandler.ErrorRate = (1-StatsSmoothingFactor)*rpcNodeHandler.ErrorRate + StatsSmoothingFactor
+	rpcNodeHandler.ConsecutiveErrors++
+
+	if rpcNodeHandler.ConsecutiveErrors >= QuarantineErrorThreshold {
+		rpcNodeHandler.quarantine()
+	}
+}
+
+// recordVerificationError records a response that failed verification, and quarantines the node right away.
+func (rpcNodeHandler *RPCNodeHandler) recordVerificationError() {
+	rpcNodeHandler.statsLock.Lock()
+	defer rpcNodeHandler.statsLock.Unlock()
+
+	rpcNodeHandler.Errors++
+	rpcNodeHandler.VerificationErrors++
+	rpcNodeHandler.ErrorRate = (1-StatsSmoothingFactor)*rpcNodeHandler.ErrorRate + StatsSmoothingFactor
+	rpcNodeHandler.quarantine()
+}
+
+// quarantine excludes the node from selection, with exponential backoff. Must be called with the stats lock held.
+func (rpcNodeHandler *RPCNodeHandler) quarantine() {
+	duration := QuarantineBaseDuration * time.Duration(math.Pow(2, float64(rpcNodeHandler.Quarantines)))
+	if duration > QuarantineMaxDuration || duration <= 0 {
+		duration = QuarantineMaxDuration
+	}
+
+	rpcNodeHandler.Quarantines++
+	rpcNodeHandler.ConsecutiveErrors = 0
+	rpcNodeHandler.QuarantinedUntil = time.Now().UTC().Add(duration)
+}
+
+// score returns the QoS score of the node in (0, 1], higher is better. Must be called with the stats lock held.
+func (rpcNodeHandler *RPCNodeHandler) score() float64 {
+	// Nodes that served bad data are penalized beyond the error rate (which recovers quickly).
+	reliability := (1 - rpcNodeHandler.ErrorRate) / float64(1+rpcNodeHandler.VerificationErrors)
+	latencyFactor := 1 / (1 + rpcNodeHandler.Latency.Seconds())
+
+	return math.Max(reliability*latencyFactor, math.SmallestNonzeroFloat64)
+}
+
+// isQuarantined checks if the node is quarantined. Must be called with the stats lock held.
+func (rpcNodeHandler *RPCNodeHandler) isQuarantined(now time.Time) bool {
+	return now.Before(rpcNodeHandler.QuarantinedUntil)
+}
+
+// Stats returns the node stats and QoS score.
+func (rpcNodeHandler *RPCNodeHandler) Stats() RPCNodeStats {
+	rpcNodeHandler.statsLock.Lock()
+	defer rpcNodeHandler.statsLock.Unlock()
+
+	return RPCNodeStats{
+		Address:            rpcNodeHandler.Address,
+		Calls:              rpcNodeHandler.Calls,
+		Errors:             rpcNodeHandler.Errors,
+		VerificationErrors: rpcNodeHandler.VerificationErrors,
+		ErrorRate:          rpcNodeHandler.ErrorRate,
+		Latency:            rpcNodeHandler.Latency,
+		Score:              rpcNodeHandler.score(),
+		QuarantinedUntil:   rpcNodeHandler.QuarantinedUntil,
+		LastCalledAt:       rpcNodeHandler.LastCalledAt,
+	}
+}
+
+// GetRPCNodeStats returns the stats of the RPC nodes used for sync, ordered by address.
+func (ctx *Context) GetRPCNodeStats() []RPCNodeStats {
+	ctx.nodeLock.RLock()
+	defer ctx.nodeLock.RUnlock()
+
+	return getNodeStats(ctx.secondaryNodes)
+}
+
+// GetPeerNodeStats returns the stats of the lite peers used for sync, ordered by address.
+func (ctx *Context) GetPeerNodeStats() []RPCNodeStats {
+	ctx.nodeLock.RLock()
+	defer ctx.nodeLock.RUnlock()
+
+	return getNodeStats(ctx.peerNodes)
+}
+
+// getNodeStats returns the stats of the nodes, ordered by address. Must be called with the node lock held.
+func getNodeStats(nodes map[string]*RPCNodeHandler) []RPCNodeStats {
+	stats := []RPCNodeStats{}
+	for _, node := range nodes {
+		stats = append(stats, node.Stats())
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Address < stats[j].Address
+	})
+
+	return stats
+}
+
+// selectRPCNodeHandler picks an RPC node at random, weighted by QoS score, skipping quarantined nodes.
+// If all nodes are quarantined, picks the one whose quarantine ends first.
+func selectRPCNodeHandler(ctx *Context) *RPCNodeHandler {
+	ctx.nodeLock.RLock()
+	defer ctx.nodeLock.RUnlock()
+
+	candidate, fallback := selectNodeHandler(ctx.secondaryNodes)
+	if candidate == nil {
+		return fallback
+	}
+
+	return candidate
+}
+
+// selectPeerNodeHandler picks a lite peer, like selectRPCNodeHandler.
+// Returns nil if there are no peers, or all are quarantined, as RPC nodes can be used instead.
+func selectPeerNodeHandler(ctx *Context) *RPCNodeHandler {
+	ctx.nodeLock.RLock()
+	defer ctx.nodeLock.RUnlock()
+
+	candidate, _ := selectNodeHandler(ctx.peerNodes)
+
+	return candidate
+}
+
+// selectNodeHandler picks a node at random (weighted by QoS score) that isn't quarantined,
+// along with the quarantined node whose quarantine ends first. Must be called with the node lock held.
+func selectNodeHandler(nodes map[string]*RPCNodeHandler) (*RPCNodeHandler, *RPCNodeHandler) {
+	now := time.Now().UTC()
+
+	var candidates []*RPCNodeHandler
+	var scores []float64
+	var totalScore float64
+	var fallback *RPCNodeHandler
+	var fallbackUntil time.Time
+
+	for _, node := range nodes {
+		node.statsLock.Lock()
+		quarantined, quarantinedUntil, score := node.isQuarantined(now), node.QuarantinedUntil, node.score()
+		node.statsLock.Unlock()
+
+		if quarantined {
+			if fallback == nil || quarantinedUntil.Before(fallbackUntil) {
+				fallback, fallbackUntil = node, quarantinedUntil
+			}
+
+			continue
+		}
+
+		candidates = append(candidates, node)
+		scores = append(scores, score)
+		totalScore += score
+	}
+
+	if len(candidates) == 0 {
+		return nil, fallback
+	}
+
+	pick := rand.Float64() * totalScore
+	for index, score := range scores {
+		pick -= score
+		if pick <= 0 {
+			return candidates[index], fallback
+		}
+	}
+
+	return candidates[len(candidates)-1], fallback
+}
+
+// getErrorWaitDuration returns the wait duration after consecutive sync errors, with exponential backoff.
+func getErrorWaitDuration(syncErrors int) time.Duration {
+	wait := ErrorWaitDurationMillis * math.Pow(2, float64(syncErrors-1))
+	if wait > MaxErrorWaitDurationMillis {
+		wait = MaxErrorWaitDurationMillis
+	}
+
+	return time.Duration(wait) * time.Millisecond
+}
@@ -0,0 +1,526 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package sync
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wirelineio/wns/x/bond"
+	ns "github.com/wirelineio/wns/x/nameservice"
+)
+
+// SnapshotVersion is the snapshot format version.
+const SnapshotVersion = 1
+
+// Number of imported values fetched (at the snapshot height) per batch, for verification.
+const snapshotVerifyBatchSize = 1000
+
+// Snapshot archive (tar.gz) entries, in order.
+const (
+	SnapshotMetadataFile = "metadata.json"
+	SnapshotDataFile     = "data"
+)
+
+// provenKeyPrefixes are the prefixes (by module store) of the synced store values, which must have proofs to be
+// imported from a snapshot. Indexes are rebuilt from these values on import.
+var provenKeyPrefixes = map[string][][]byte{
+	ns.StoreKey:   {ns.PrefixCIDToRecordIndex, ns.PrefixNameAuthorityRecordIndex, ns.PrefixWRNToNameRecordIndex, ns.PrefixBlockChangesetIndex},
+	bond.StoreKey: {bond.PrefixIDToBondIndex, bond.PrefixBlockChangesetIndex},
+}
+
+// SnapshotMetadata describes a snapshot of the lite node db.
+// The data file is a sequence of (uvarint length prefixed) key and value pairs.
+type SnapshotMetadata struct {
+	Version   int       `json:"version"`
+	ChainID   string    `json:"chainId"`
+	Height    int64     `json:"height"`
+	AppHash   string    `json:"appHash"`
+	Keys      int64     `json:"keys"`
+	Checksum  string    `json:"checksum"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// ExportSnapshot writes the db contents to a snapshot archive, tied to the last synced height and its certified app hash.
+// Note: The node must be stopped, so that the db doesn't change during export.
+func ExportSnapshot(ctx *Context, path string) (*SnapshotMetadata, error) {
+	if !ctx.keeper.HasStatusRecord() {
+		return nil, errors.New("node not initialized")
+	}
+
+	height := ctx.keeper.GetStatusRecord().LastSyncedHeight
+
+	// Values without proofs (e.g. imported from a genesis file) can't be verified on import.
+	err := checkProofsExist(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The AppHash for height H is in header H+1.
+	commit, err := Verify(ctx, height+1)
+	if err != nil {
+		return nil, err
+	}
+
+	// The checksum and number of keys go in the metadata, which precedes the data in the archive.
+	dataSize, keys, checksum, err := writeSnapshotData(ctx, ioutil.Discard)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := SnapshotMetadata{
+		Version:   SnapshotVersion,
+		ChainID:   ctx.config.ChainID,
+		Height:    height,
+		AppHash:   hex.EncodeToString(commit.Header.AppHash),
+		Keys:      keys,
+		Checksum:  hex.EncodeToString(checksum),
+		CreatedAt: time.Now().UTC(),
+	}
+
+	metadataBytes, err := json.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	gzipWriter := gzip.NewWriter(file)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	err = tarWriter.WriteHeader(&tar.Header{Name: SnapshotMetadataFile, Mode: 0644, Size: int64(len(metadataBytes)), ModTime: metadata.CreatedAt})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tarWriter.Write(metadataBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tarWriter.WriteHeader(&tar.Header{Name: SnapshotDataFile, Mode: 0644, Size: dataSize, ModTime: metadata.CreatedAt})
+	if err != nil {
+		return nil, err
+	}
+
+	_, _, _, err = writeSnapshotData(ctx, tarWriter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tarWriter.Close(); err != nil {
+		return nil, err
+	}
+
+	if err = gzipWriter.Close(); err != nil {
+		return nil, err
+	}
+
+	return &metadata, file.Close()
+}
+
+// writeSnapshotData writes the db KV pairs, and returns the size, number of keys and SHA-256 checksum of the data.
+func writeSnapshotData(ctx *Context, writer io.Writer) (int64, int64, []byte, error) {
+	hasher := sha256.New()
+	counter := &countingWriter{writer: io.MultiWriter(writer, hasher)}
+	bufferedWriter := bufio.NewWriter(counter)
+
+	var keys int64
+
+	itr := ctx.store.Iterator(nil, nil)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		for _, bz := range [][]byte{itr.Key(), itr.Value()} {
+			lengthBytes := make([]byte, binary.MaxVarintLen64)
+			_, err := bufferedWriter.Write(lengthBytes[:binary.PutUvarint(lengthBytes, uint64(len(bz)))])
+			if err != nil {
+				return 0, 0, nil, err
+			}
+
+			_, err = bufferedWriter.Write(bz)
+			if err != nil {
+				return 0, 0, nil, err
+			}
+		}
+
+		keys++
+	}
+
+	err := bufferedWriter.Flush()
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	return counter.count, keys, hasher.Sum(nil), nil
+}
+
+// initFromSnapshot imports a snapshot, after checking its checksum and verifying its app hash against
+// the certified commit. Each imported value must have a proof, which is verified against the certified app hash
+// at its own height. Each value (or its absence) is then checked against the proven value at the snapshot height,
+// so that outdated values are rejected. Indexes are rebuilt from the verified values. Sync then resumes from the snapshot height.
+// Note: Values omitted from the snapshot can't be detected.
+func initFromSnapshot(ctx *Context) {
+	if ctx.config.SyncFilter != nil {
+		ctx.log.Fatalln("Sync filter can't be passed with `--from-snapshot`, it's part of the snapshot.")
+	}
+
+	path := ctx.config.InitFromSnapshot
+
+	// First pass, check the archive before writing anything to the db.
+	metadata, err := readSnapshot(path, nil)
+	if err != nil {
+		ctx.log.Fatalln("Error reading snapshot:", err)
+	}
+
+	ctx.log.Infoln("Snapshot height:", metadata.Height, "app hash:", metadata.AppHash, "keys:", metadata.Keys)
+
+	if metadata.ChainID != ctx.config.ChainID {
+		ctx.log.Fatalln("Chain ID mismatch:", metadata.ChainID)
+	}
+
+	// The AppHash for height H is in header H+1.
+	commit, err := Verify(ctx, metadata.Height+1)
+	if err != nil {
+		ctx.log.Fatalln("Error verifying snapshot app hash:", err)
+	}
+
+	if metadata.AppHash != hex.EncodeToString(commit.Header.AppHash) {
+		ctx.log.Fatalln("Snapshot app hash doesn't match certified app hash:", hex.EncodeToString(commit.Header.AppHash))
+	}
+
+	// Second pass, import the synced values and their proofs. The sync status is only saved once the import is verified.
+	_, err = readSnapshot(path, func(key []byte, value []byte) {
+		if isImportedSnapshotKey(key) {
+			ctx.store.Set(key, value)
+		}
+	})
+	if err == nil {
+		err = checkProofsExist(ctx)
+	}
+	if err == nil {
+		err = verifyProofs(ctx, metadata.Height)
+	}
+	if err == nil {
+		err = verifyValues(ctx, metadata.Height)
+	}
+	if err != nil {
+		clearStore(ctx)
+		ctx.log.Fatalln("Error importing snapshot:", err)
+	}
+
+	ctx.keeper.BuildAttributeIndex()
+	ctx.keeper.BuildNameIndex()
+	ctx.keeper.BuildBondOwnerIndex()
+
+	// Create sync status record.
+	ctx.keeper.SaveStatus(Status{LastSyncedHeight: metadata.Height})
+}
+
+// isImportedSnapshotKey checks if the snapshot key is imported, i.e. it's a synced store value, a proof or the sync filter.
+// Indexes aren't imported (they're rebuilt from the verified values), nor is undo data.
+func isImportedSnapshotKey(key []byte) bool {
+	if bytes.Equal(key, ns.KeySyncFilter) {
+		return true
+	}
+
+	storeName, storeKey := ns.StoreKey, key
+	if bytes.HasPrefix(key, ns.PrefixBondStore) {
+		storeName, storeKey = bond.StoreKey, key[len(ns.PrefixBondStore):]
+	}
+
+	if bytes.HasPrefix(storeKey, ns.PrefixStoreKeyToProofIndex) {
+		return true
+	}
+
+	for _, keyPrefix := range provenKeyPrefixes[storeName] {
+		if bytes.HasPrefix(storeKey, keyPrefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkProofsExist checks that every synced store value has a proof.
+func checkProofsExist(ctx *Context) error {
+	for storeName, keyPrefixes := range provenKeyPrefixes {
+		kvStore, err := getModuleStore(ctx.store, storeName)
+		if err != nil {
+			return err
+		}
+
+		for _, keyPrefix := range keyPrefixes {
+			itr := sdk.KVStorePrefixIterator(kvStore, keyPrefix)
+			for ; itr.Valid(); itr.Next() {
+				if key := itr.Key(); !kvStore.Has(GetProofIndexKey(key)) {
+					itr.Close()
+					return fmt.Errorf("missing proof for %s store key %X", storeName, key)
+				}
+			}
+			itr.Close()
+		}
+	}
+
+	return nil
+}
+
+// verifyProofs verifies every stored proof (and the value, or its absence) against the certified app hash at its height.
+func verifyProofs(ctx *Context, height int64) error {
+	// Certified app hashes by height, as many values are synced at the same height.
+	appHashes := make(map[int64][]byte)
+
+	for storeName := range provenKeyPrefixes {
+		err := verifyStoreProofs(ctx, storeName, height, appHashes)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func verifyStoreProofs(ctx *Context, storeName string, height int64, appHashes map[int64][]byte) error {
+	kvStore, err := getModuleStore(ctx.store, storeName)
+	if err != nil {
+		return err
+	}
+
+	itr := sdk.KVStorePrefixIterator(kvStore, ns.PrefixStoreKeyToProofIndex)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		key := itr.Key()[len(ns.PrefixStoreKeyToProofIndex):]
+
+		var proof Proof
+		err := ctx.codec.UnmarshalBinaryBare(itr.Value(), &proof)
+		if err != nil {
+			return fmt.Errorf("invalid proof for %s store key %X: %s", storeName, key, err)
+		}
+
+		if proof.Height <= 0 || proof.Height > height {
+			return fmt.Errorf("invalid proof height %d for %s store key %X", proof.Height, storeName, key)
+		}
+
+		appHash, exists := appHashes[proof.Height]
+		if !exists {
+			// The AppHash for height H is in header H+1.
+			commit, err := Verify(ctx, proof.Height+1)
+			if err != nil {
+				return err
+			}
+
+			appHash = commit.Header.AppHash
+			appHashes[proof.Height] = appHash
+		}
+
+		err = verifyMerkleProof(storeName, key, kvStore.Get(key), proof.Proof, appHash)
+		if err != nil {
+			return fmt.Errorf("%s store key %X: %s", storeName, key, err)
+		}
+	}
+
+	return nil
+}
+
+// verifyValues checks every synced value (or its absence) against the proven value at the height, fetched from the RPC nodes (and lite peers).
+// A value with a valid proof at an earlier height might have changed since.
+func verifyValues(ctx *Context, height int64) error {
+	for storeName := range provenKeyPrefixes {
+		err := verifyStoreValues(ctx, storeName, height)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func verifyStoreValues(ctx *Context, storeName string, height int64) error {
+	kvStore, err := getModuleStore(ctx.store, storeName)
+	if err != nil {
+		return err
+	}
+
+	keys := [][]byte{}
+
+	itr := sdk.KVStorePrefixIterator(kvStore, ns.PrefixStoreKeyToProofIndex)
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, itr.Key()[len(ns.PrefixStoreKeyToProofIndex):])
+	}
+	itr.Close()
+
+	ctx.log.Infoln("Verifying", len(keys), storeName, "store values at height", height)
+
+	for start := 0; start < len(keys); start += snapshotVerifyBatchSize {
+		end := start + snapshotVerifyBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		values, err := fetchStoreValues(ctx, storeName, keys[start:end], height)
+		if err != nil {
+			return err
+		}
+
+		for _, value := range values {
+			if !bytes.Equal(kvStore.Get(value.key), value.value) {
+				return fmt.Errorf("%s store key %X doesn't match the value at height %d", storeName, value.key, height)
+			}
+		}
+	}
+
+	return nil
+}
+
+// clearStore deletes all keys, after a failed import (the node isn't initialized until the sync status is saved).
+func clearStore(ctx *Context) {
+	keys := [][]byte{}
+
+	itr := ctx.store.Iterator(nil, nil)
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, itr.Key())
+	}
+	itr.Close()
+
+	for _, key := range keys {
+		ctx.store.Delete(key)
+	}
+}
+
+// readSnapshot reads the snapshot metadata and checks the data against the checksum, calling fn (if any) with each KV pair.
+func readSnapshot(path string, fn func(key []byte, value []byte)) (*SnapshotMetadata, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	gzipReader, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, err
+	}
+
+	tarReader := tar.NewReader(gzipReader)
+
+	header, err := tarReader.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	if header.Name != SnapshotMetadataFile {
+		return nil, fmt.Errorf("expected %s, found %s", SnapshotMetadataFile, header.Name)
+	}
+
+	var metadata SnapshotMetadata
+	err = json.NewDecoder(tarReader).Decode(&metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	if metadata.Version != SnapshotVersion {
+		return nil, fmt.Errorf("unsupported snapshot version: %d", metadata.Version)
+	}
+
+	header, err = tarReader.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	if header.Name != SnapshotDataFile {
+		return nil, fmt.Errorf("expected %s, found %s", SnapshotDataFile, header.Name)
+	}
+
+	hasher := sha256.New()
+	keys, err := readSnapshotData(bufio.NewReader(io.TeeReader(tarReader, hasher)), header.Size, fn)
+	if err != nil {
+		return nil, err
+	}
+
+	if keys != metadata.Keys {
+		return nil, fmt.Errorf("expected %d keys, found %d", metadata.Keys, keys)
+	}
+
+	if hex.EncodeToString(hasher.Sum(nil)) != metadata.Checksum {
+		return nil, errors.New("checksum mismatch")
+	}
+
+	return &metadata, nil
+}
+
+// readSnapshotData reads the KV pairs from the data file (of the given size), and returns the number of keys.
+func readSnapshotData(reader *bufio.Reader, size int64, fn func(key []byte, value []byte)) (int64, error) {
+	var keys int64
+
+	for {
+		key, err := readLengthPrefixed(reader, size)
+		if err == io.EOF {
+			return keys, nil
+		}
+
+		if err != nil {
+			return 0, err
+		}
+
+		value, err := readLengthPrefixed(reader, size)
+		if err != nil {
+			return 0, fmt.Errorf("truncated data: %s", err)
+		}
+
+		if fn != nil {
+			fn(key, value)
+		}
+
+		keys++
+	}
+}
+
+func readLengthPrefixed(reader *bufio.Reader, maxLength int64) ([]byte, error) {
+	length, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	// Guard against allocating huge buffers for corrupt data.
+	if length > uint64(maxLength) {
+		return nil, fmt.Errorf("invalid length: %d", length)
+	}
+
+	bz := make([]byte, length)
+	_, err = io.ReadFull(reader, bz)
+	if err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// countingWriter counts the bytes written.
+type countingWriter struct {
+	writer io.Writer
+	count  int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.writer.Write(p)
+	w.count += int64(n)
+
+	return n, err
+}
@@ -0,0 +1,94 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// NewBlockSubscriber is the subscriber name for NewBlock events.
+const NewBlockSubscriber = "wnsd-lite"
+
+// NewBlockTimeoutMillis is the max. time without NewBlock events before the subscription is considered dropped.
+// Note: Also triggers on chains that don't create empty blocks, in which case sync falls back to polling until the next block.
+const NewBlockTimeoutMillis = 60 * 1000
+
+// ResubscribeIntervalMillis is the wait duration before resubscribing to NewBlock events, after the subscription drops.
+const ResubscribeIntervalMillis = 10 * 1000
+
+// SubscribedSyncIntervalInMillis is the interval for initiating incremental sync (as a safety net) while subscribed to NewBlock events.
+const SubscribedSyncIntervalInMillis = 60 * 1000
+
+// subscribeNewBlocks triggers sync as soon as blocks are committed on the primary node.
+// Sync falls back to polling while the subscription is down (see waitAfterSync).
+func subscribeNewBlocks(ctx *Context) {
+	for {
+		err := watchNewBlocks(ctx)
+		atomic.StoreInt32(&ctx.subscribed, 0)
+		ctx.log.Warnln("NewBlock subscription dropped, falling back to polling:", err)
+
+		time.Sleep(ResubscribeIntervalMillis * time.Millisecond)
+	}
+}
+
+// watchNewBlocks subscribes to NewBlock events on the primary node, and notifies them until the subscription drops.
+func watchNewBlocks(ctx *Context) error {
+	// Use a dedicated client, as a stopped client can't be restarted.
+	primaryNode := getPrimaryNode(ctx)
+	client := rpcclient.NewHTTP(primaryNode.Address, "/websocket")
+	err := client.Start()
+	if err != nil {
+		return err
+	}
+
+	defer client.Stop()
+
+	events, err := client.Subscribe(context.Background(), NewBlockSubscriber, tmtypes.EventQueryNewBlock.String())
+	if err != nil {
+		return err
+	}
+
+	atomic.StoreInt32(&ctx.subscribed, 1)
+	ctx.log.Infoln("Subscribed to new blocks:", primaryNode.Address)
+
+	// Note: The events channel is never closed (the client reconnects and resubscribes internally), so detect drops using a timeout.
+	for {
+		// Resubscribe to the new primary node on failover (see divergence.go).
+		if getPrimaryNode(ctx) != primaryNode {
+			return errors.New("primary node changed")
+		}
+
+		select {
+		case event := <-events:
+			if data, ok := event.Data.(tmtypes.EventDataNewBlock); ok {
+				ctx.log.Debugln("New block:", data.Block.Height)
+			}
+
+			notifyNewBlock(ctx)
+		case <-time.After(NewBlockTimeoutMillis * time.Millisecond):
+			return errors.New("no new blocks received")
+		}
+	}
+}
+
+// notifyNewBlock wakes up the sync loop, if it's waiting.
+func notifyNewBlock(ctx *Context) {
+	select {
+	case ctx.newBlocks <- struct{}{}:
+	default:
+		// Sync already pending.
+	}
+}
+
+// isSubscribed checks if the node is subscribed to NewBlock events.
+func isSubscribed(ctx *Context) bool {
+	return atomic.LoadInt32(&ctx.subscribed) == 1
+}
@@ -6,14 +6,17 @@ package sync
 
 import (
 	"encoding/json"
-	"errors"
 	"io/ioutil"
-	"math/rand"
 	"os"
 	"path/filepath"
-	"reflect"
+	"sync"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storeTypes "github.com/cosmos/cosmos-sdk/store/types"
+	pkgErrors "github.com/pkg/errors"
+	"github.com/wirelineio/wns/x/bond"
 	ns "github.com/wirelineio/wns/x/nameservice"
 )
 
@@ -42,14 +45,16 @@ func Init(ctx *Context, height int64) {
 		ctx.log.Fatalln("Node already initialized, aborting.")
 	}
 
-	if !ctx.config.InitFromNode && !ctx.config.InitFromGenesisFile {
-		ctx.log.Fatalln("Must pass one of `--from-node` and `--from-genesis-file`.")
+	if !ctx.config.InitFromNode && !ctx.config.InitFromGenesisFile && ctx.config.InitFromSnapshot == "" {
+		ctx.log.Fatalln("Must pass one of `--from-node`, `--from-genesis-file` and `--from-snapshot`.")
 	}
 
 	if ctx.config.InitFromNode {
 		initFromNode(ctx)
 	} else if ctx.config.InitFromGenesisFile {
 		initFromGenesisFile(ctx, height)
+	} else if ctx.config.InitFromSnapshot != "" {
+		initFromSnapshot(ctx)
 	}
 }
 
@@ -60,8 +65,26 @@ func Start(ctx *Context) {
 		ctx.log.Fatalln("Node not initialized, aborting.")
 	}
 
+	// Nodes initialized before the attribute index was introduced need it to be built once.
+	if !ctx.keeper.HasAttributeIndex() {
+		ctx.log.Infoln("Building record attribute index.")
+		ctx.keeper.BuildAttributeIndex()
+	}
+
+	// Nodes initialized before bond sync was introduced need the bonds to be imported once.
+	if !ctx.keeper.HasBonds() {
+		height := ctx.keeper.GetStatusRecord().LastSyncedHeight
+		ctx.log.Infoln("Importing bonds at height:", height)
+		err := importBonds(ctx, height)
+		if err != nil {
+			ctx.log.Fatalln("Error importing bonds:", err)
+		}
+	}
+
 	go dumpConnectionStatsOnTimer(ctx)
 
+	go subscribeNewBlocks(ctx)
+
 	if ctx.config.SyncTimeoutMins > 0 {
 		ctx.log.Infoln("Sync timeout ON:", ctx.config.SyncTimeoutMins)
 		go exitOnSyncTimeout(ctx)
@@ -69,9 +92,18 @@ func Start(ctx *Context) {
 		ctx.log.Infoln("Sync timeout OFF.")
 	}
 
-	if ctx.config.Endpoint != "" {
-		go discoverRPCNodesOnTimer(ctx)
-		ctx.log.Infoln("RPC endpoint discovery ON:", ctx.config.Endpoint)
+	if ctx.config.PeerListenAddress != "" {
+		go servePeers(ctx)
+		ctx.log.Infoln("Serving lite peers ON:", ctx.config.PeerListenAddress)
+	} else {
+		ctx.log.Infoln("Serving lite peers OFF.")
+	}
+
+	if discoverers := NewDiscoverers(ctx.config); len(discoverers) > 0 {
+		go discoverRPCNodesOnTimer(ctx, discoverers)
+		for _, discoverer := range discoverers {
+			ctx.log.Infoln("RPC endpoint discovery ON:", discoverer.Name())
+		}
 	} else {
 		ctx.log.Infoln("RPC endpoint discovery OFF.")
 	}
@@ -80,123 +112,435 @@ func Start(ctx *Context) {
 	lastSyncedHeight := syncStatus.LastSyncedHeight
 
 	for {
-		chainCurrentHeight, err := ctx.primaryNode.getCurrentHeight()
+		primaryNode := getPrimaryNode(ctx)
+		chainCurrentHeight, err := primaryNode.getCurrentHeight()
 		if err != nil {
 			logErrorAndWait(ctx, err)
 			continue
 		}
 
 		if lastSyncedHeight > chainCurrentHeight {
-			// Maybe we've connected to a new primary node (after restart) and that isn't fully caught up, yet. Just wait.
-			logErrorAndWait(ctx, errors.New("last synced height greater than current chain height"))
+			// Maybe we've connected to a new primary node (after restart) and that isn't fully caught up, yet.
+			// Just wait, unless it persists (see divergence.go).
+			err = pkgErrors.Wrapf(ErrPrimaryNodeBehind, "chain height %d", chainCurrentHeight)
+			handleDivergence(ctx, primaryNode, lastSyncedHeight, err)
+			logErrorAndWait(ctx, err)
 			continue
 		}
 
 		newSyncHeight := lastSyncedHeight + 1
 		if newSyncHeight > chainCurrentHeight {
 			// Can't sync beyond chain height, just wait.
-			waitAfterSync(chainCurrentHeight, chainCurrentHeight)
+			waitAfterSync(ctx, chainCurrentHeight, chainCurrentHeight)
 			continue
 		}
 
-		err = syncAtHeight(ctx, newSyncHeight)
+		// Fetch several heights ahead (when catching up), but apply them in height order.
+		lastBatchHeight := lastSyncedHeight + int64(ctx.config.SyncConcurrency)
+		if lastBatchHeight > chainCurrentHeight {
+			lastBatchHeight = chainCurrentHeight
+		}
+
+		lastSyncedHeight, err = syncHeights(ctx, newSyncHeight, lastBatchHeight, chainCurrentHeight)
 		if err != nil {
+			handleDivergence(ctx, primaryNode, lastSyncedHeight, err)
 			logErrorAndWait(ctx, err)
 			continue
 		}
 
+		ctx.syncErrors = 0
+		ctx.divergenceErrors = 0
+
+		waitAfterSync(ctx, chainCurrentHeight, lastSyncedHeight)
+	}
+}
+
+// storeValue is a (verified) store value fetched from an RPC node or lite peer.
+type storeValue struct {
+	key   []byte
+	value []byte
+	proof *Proof
+}
+
+// heightChanges are the store values that changed at a height.
+type heightChanges struct {
+	height          int64
+	changeset       *ns.BlockChangeset
+	changesetValue  storeValue
+	records         []storeValue
+	nameAuthorities []storeValue
+	names           []storeValue
+
+	// Bonds changed at the height (deleted bonds have nil values).
+	bondChangeset      *bond.BlockChangeset
+	bondChangesetValue storeValue
+	bonds              []storeValue
+
+	// Records referenced by the synced names, which must be synced even if filtered out (partial sync).
+	referencedRecords map[ns.ID]bool
+}
+
+// syncHeights fetches the changes for the range of heights concurrently, applies them in height order
+// and saves the sync status after each height. Returns the last synced height.
+func syncHeights(ctx *Context, fromHeight int64, toHeight int64, chainCurrentHeight int64) (int64, error) {
+	results := make([]chan error, toHeight-fromHeight+1)
+	changes := make([]*heightChanges, len(results))
+
+	for index := range results {
+		// Buffered, so that fetches don't block if applying changes fails at an earlier height.
+		results[index] = make(chan error, 1)
+
+		go func(index int) {
+			var err error
+			changes[index], err = fetchAtHeight(ctx, fromHeight+int64(index))
+			results[index] <- err
+		}(index)
+	}
+
+	lastSyncedHeight := fromHeight - 1
+	for index, result := range results {
+		err := <-result
+		if err != nil {
+			return lastSyncedHeight, err
+		}
+
+		err = applyAtHeight(ctx, changes[index])
+		if err != nil {
+			return lastSyncedHeight, err
+		}
+
 		// Saved last synced height in db.
-		lastSyncedHeight = newSyncHeight
+		lastSyncedHeight++
 		catchingUp := (chainCurrentHeight - lastSyncedHeight) > SyncLaggingMinHeightDiff
 
 		ctx.keeper.SaveStatus(Status{
 			LastSyncedHeight: lastSyncedHeight,
 			CatchingUp:       catchingUp,
 		})
-
-		waitAfterSync(chainCurrentHeight, lastSyncedHeight)
 	}
+
+	return lastSyncedHeight, nil
 }
 
-// syncAtHeight runs a sync cycle for the given height.
-func syncAtHeight(ctx *Context, height int64) error {
-	rpc := getRandomRPCNodeHandler(ctx)
+// fetchAtHeight fetches (and verifies) the changes at the given height, spreading requests across the RPC nodes (and lite peers).
+// Names and authorities outside the sync filter aren't fetched, nor are records that can't be synced (see applyAtHeight).
+func fetchAtHeight(ctx *Context, height int64) (*heightChanges, error) {
+	ctx.log.Infoln("Syncing at height:", height)
 
-	ctx.log.Infoln("Syncing from", rpc.Address, "at height:", height)
+	ctx.fetchSlots <- struct{}{}
+	changeset, changesetValue, err := getBlockChangeset(ctx, height)
+	<-ctx.fetchSlots
+	if err != nil {
+		return nil, err
+	}
 
-	changeset, err := rpc.getBlockChangeset(ctx, height)
+	changes := heightChanges{
+		height:            height,
+		changeset:         changeset,
+		changesetValue:    changesetValue,
+		referencedRecords: make(map[ns.ID]bool),
+	}
+
+	err = fetchBondsAtHeight(ctx, height, &changes)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if changeset.Height <= 0 {
 		// No changeset for this block, ignore.
-		return nil
+		return &changes, nil
 	}
 
 	ctx.log.Debugln("Syncing changeset:", changeset)
 
-	// Sync records.
-	err = rpc.syncRecords(ctx, height, changeset.Records)
+	filter := ctx.config.SyncFilter
+
+	nameAuthorityKeys := [][]byte{}
+	for _, name := range changeset.NameAuthorities {
+		if filter.KeepAuthority(name) {
+			nameAuthorityKeys = append(nameAuthorityKeys, ns.GetNameAuthorityIndexKey(name))
+		}
+	}
+
+	changes.nameAuthorities, err = fetchValues(ctx, nameAuthorityKeys, height)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	// Names are fetched before records, as the records they point to must also be synced.
+	nameKeys := [][]byte{}
+	for _, name := range changeset.Names {
+		if filter.KeepName(name) {
+			nameKeys = append(nameKeys, ns.GetNameRecordIndexKey(name))
+		}
 	}
 
-	// Sync name authority records.
-	err = rpc.syncNameAuthorityRecords(ctx, height, changeset.NameAuthorities)
+	changes.names, err = fetchValues(ctx, nameKeys, height)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	referencedRecordIDs := []ns.ID{}
+	for _, name := range changes.names {
+		if name.value == nil {
+			continue
+		}
+
+		var nameRecord ns.NameRecord
+		ctx.codec.MustUnmarshalBinaryBare(name.value, &nameRecord)
+		if nameRecord.ID != "" && !changes.referencedRecords[nameRecord.ID] {
+			changes.referencedRecords[nameRecord.ID] = true
+			referencedRecordIDs = append(referencedRecordIDs, nameRecord.ID)
+		}
+	}
+
+	recordKeys := [][]byte{}
+	fetchRecord := make(map[ns.ID]bool)
+	for _, id := range changeset.Records {
+		// Records are only filtered by attributes after they are fetched.
+		// Records already synced must be kept up to date (e.g. on renewal or deletion).
+		if filter.IsEmpty() || len(filter.RecordAttributes) > 0 || changes.referencedRecords[id] || ctx.keeper.HasRecord(id) {
+			fetchRecord[id] = true
+			recordKeys = append(recordKeys, ns.GetRecordIndexKey(id))
+		}
+	}
+
+	// Record closure: Names may point to records (outside the filter) that haven't been synced.
+	for _, id := range referencedRecordIDs {
+		if !fetchRecord[id] && !ctx.keeper.HasRecord(id) {
+			fetchRecord[id] = true
+			recordKeys = append(recordKeys, ns.GetRecordIndexKey(id))
+		}
 	}
 
-	// Sync name records.
-	err = rpc.syncNameRecords(ctx, height, changeset.Names)
+	changes.records, err = fetchValues(ctx, recordKeys, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &changes, nil
+}
+
+// fetchBondsAtHeight fetches (and verifies) the bonds changed at the given height.
+func fetchBondsAtHeight(ctx *Context, height int64, changes *heightChanges) error {
+	ctx.fetchSlots <- struct{}{}
+	changeset, changesetValue, err := getBondChangeset(ctx, height)
+	<-ctx.fetchSlots
 	if err != nil {
 		return err
 	}
 
-	// Flush cache changes to underlying store.
-	ctx.cache.Write()
+	changes.bondChangesetValue = changesetValue
+	if changeset == nil {
+		return nil
+	}
 
-	return nil
+	bondKeys := make([][]byte, len(changeset.Bonds))
+	for index, id := range changeset.Bonds {
+		bondKeys[index] = bond.GetBondIndexKey(id)
+	}
+
+	changes.bondChangeset = changeset
+	changes.bonds, err = fetchStoreValues(ctx, bond.StoreKey, bondKeys, height)
+
+	return err
+}
+
+// fetchValues fetches (and verifies) the nameservice store values for the keys concurrently.
+func fetchValues(ctx *Context, keys [][]byte, height int64) ([]storeValue, error) {
+	return fetchStoreValues(ctx, ns.StoreKey, keys, height)
 }
 
-func (rpc *RPCNodeHandler) syncRecords(ctx *Context, height int64, records []ns.ID) error {
-	for _, id := range records {
-		recordKey := ns.GetRecordIndexKey(id)
-		value, err := rpc.getStoreValue(ctx, recordKey, height)
+// fetchStoreValues fetches (and verifies) the module store values for the keys concurrently, spreading requests across the RPC nodes (and lite peers).
+func fetchStoreValues(ctx *Context, storeKey string, keys [][]byte, height int64) ([]storeValue, error) {
+	values := make([]storeValue, len(keys))
+	errs := make([]error, len(keys))
+
+	var wg sync.WaitGroup
+	for index, key := range keys {
+		wg.Add(1)
+
+		go func(index int, key []byte) {
+			defer wg.Done()
+
+			ctx.fetchSlots <- struct{}{}
+			defer func() { <-ctx.fetchSlots }()
+
+			values[index], errs[index] = fetchStoreValue(ctx, storeKey, key, height)
+		}(index, key)
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
 		if err != nil {
-			return err
+			return nil, err
+		}
+	}
+
+	return values, nil
+}
+
+// fetchStoreValue fetches (and verifies) a module store value, from a lite peer if it has the value at the height, otherwise from an RPC node.
+func fetchStoreValue(ctx *Context, storeKey string, key []byte, height int64) (storeValue, error) {
+	if peer := selectPeerNodeHandler(ctx); peer != nil {
+		value, proof, err := peer.getModuleStoreValue(ctx, storeKey, key, height)
+		if err == nil {
+			return storeValue{key: key, value: value, proof: proof}, nil
 		}
 
-		ctx.cache.Set(recordKey, value)
+		if err != ErrValueNotAvailable {
+			ctx.log.Errorln("Error fetching from peer", peer.Address, err)
+		}
 	}
 
-	return nil
+	value, proof, err := selectRPCNodeHandler(ctx).getModuleStoreValue(ctx, storeKey, key, height)
+
+	return storeValue{key: key, value: value, proof: proof}, err
 }
 
-func (rpc *RPCNodeHandler) syncNameAuthorityRecords(ctx *Context, height int64, nameAuthorities []string) error {
-	for _, name := range nameAuthorities {
-		nameAuhorityRecordKey := ns.GetNameAuthorityIndexKey(name)
-		value, err := rpc.getStoreValue(ctx, nameAuhorityRecordKey, height)
+// applyAtHeight applies the changes at a height to the store, and notifies the changeset (restricted to the synced changes).
+func applyAtHeight(ctx *Context, changes *heightChanges) error {
+	applyBonds(ctx, changes.bonds)
+	applyChangesets(ctx, changes)
+
+	changeset := changes.changeset
+	if changeset.Height <= 0 {
+		// Flush bond and changeset changes.
+		writeCache(ctx, changes.height)
+
+		return nil
+	}
+
+	filter := ctx.config.SyncFilter
+	if !filter.IsEmpty() {
+		// Records not fetched might have been synced at an earlier height (in the same batch) since, fetch them now.
+		fetched := make(map[string]bool)
+		for _, record := range changes.records {
+			fetched[string(record.key)] = true
+		}
+
+		recordKeys := [][]byte{}
+		for _, id := range changeset.Records {
+			recordKey := ns.GetRecordIndexKey(id)
+			if !fetched[string(recordKey)] && ctx.keeper.HasRecord(id) {
+				recordKeys = append(recordKeys, recordKey)
+			}
+		}
+
+		records, err := fetchValues(ctx, recordKeys, changeset.Height)
 		if err != nil {
 			return err
 		}
 
-		ctx.cache.Set(nameAuhorityRecordKey, value)
+		changes.records = append(changes.records, records...)
+	}
+
+	syncedChangeset := ns.BlockChangeset{Height: changeset.Height}
+	syncedChangeset.Records = applyRecords(ctx, changes.records, changes.referencedRecords)
+	syncedChangeset.NameAuthorities = applyNameAuthorityRecords(ctx, changes.nameAuthorities)
+	syncedChangeset.Names = applyNameRecords(ctx, changes.names)
+
+	// Flush cache changes to underlying store.
+	writeCache(ctx, changes.height)
+
+	if filter.IsEmpty() {
+		ctx.notifyChangeset(changeset)
+	} else {
+		ctx.notifyChangeset(&syncedChangeset)
 	}
 
 	return nil
 }
 
-func (rpc *RPCNodeHandler) syncNameRecords(ctx *Context, height int64, names []string) error {
-	for _, name := range names {
-		nameRecordKey := ns.GetNameRecordIndexKey(name)
-		value, err := rpc.getStoreValue(ctx, nameRecordKey, height)
-		if err != nil {
-			return err
+// applyRecords applies the records (within the sync filter, already synced or referenced by synced names),
+// and returns the IDs of the records applied.
+func applyRecords(ctx *Context, records []storeValue, referencedRecords map[ns.ID]bool) []ns.ID {
+	filter := ctx.config.SyncFilter
+	ids := []ns.ID{}
+
+	for _, record := range records {
+		var recordObj ns.RecordObj
+		ctx.codec.MustUnmarshalBinaryBare(record.value, &recordObj)
+
+		if !referencedRecords[recordObj.ID] && !filter.KeepRecord(recordObj.ToRecord()) && !ctx.keeper.HasRecord(recordObj.ID) {
+			continue
+		}
+
+		ctx.cache.Set(record.key, record.value)
+		setProof(ctx, ctx.cache, record.key, record.proof)
+
+		// Update attribute -> []Record ID index.
+		ns.AddRecordToAttributeIndex(ctx.cache, recordObj.ToRecord())
+
+		ids = append(ids, recordObj.ID)
+	}
+
+	return ids
+}
+
+// applyBonds saves the changed bonds, and deletes the ones that no longer exist.
+func applyBonds(ctx *Context, bonds []storeValue) {
+	bondStore := prefix.NewStore(ctx.cache, ns.PrefixBondStore)
+
+	for _, bondValue := range bonds {
+		id := bond.ID(bondValue.key[len(bond.PrefixIDToBondIndex):])
+		if bondValue.value == nil {
+			if bond.HasBond(bondStore, id) {
+				bond.DeleteBond(bondStore, bond.GetBondByID(bondStore, ctx.codec, id))
+			}
+
+			continue
 		}
 
-		ctx.cache.Set(nameRecordKey, value)
+		var bondObj bond.Bond
+		ctx.codec.MustUnmarshalBinaryBare(bondValue.value, &bondObj)
+		bond.SaveBond(bondStore, ctx.codec, bondObj)
+		setProof(ctx, bondStore, bondValue.key, bondValue.proof)
+	}
+}
+
+// applyChangesets saves the block changesets (and their proofs, also for absent changesets), so that they can be served to lite peers.
+// Changesets older than ChangesetRetentionHeights are pruned.
+func applyChangesets(ctx *Context, changes *heightChanges) {
+	bondStore := prefix.NewStore(ctx.cache, ns.PrefixBondStore)
+
+	setStoreValue(ctx, ctx.cache, changes.changesetValue)
+	setStoreValue(ctx, bondStore, changes.bondChangesetValue)
+
+	pruneHeight := changes.height - ChangesetRetentionHeights
+	if pruneHeight <= 0 {
+		return
+	}
+
+	for _, key := range [][]byte{ns.GetBlockChangesetIndexKey(pruneHeight), GetProofIndexKey(ns.GetBlockChangesetIndexKey(pruneHeight))} {
+		ctx.cache.Delete(key)
+	}
+
+	for _, key := range [][]byte{bond.GetBlockChangesetIndexKey(pruneHeight), GetProofIndexKey(bond.GetBlockChangesetIndexKey(pruneHeight))} {
+		bondStore.Delete(key)
+	}
+}
+
+func applyNameAuthorityRecords(ctx *Context, nameAuthorities []storeValue) []string {
+	names := []string{}
+
+	for _, nameAuthority := range nameAuthorities {
+		ctx.cache.Set(nameAuthority.key, nameAuthority.value)
+		setProof(ctx, ctx.cache, nameAuthority.key, nameAuthority.proof)
+		names = append(names, string(nameAuthority.key[len(ns.PrefixNameAuthorityRecordIndex):]))
+	}
+
+	return names
+}
+
+func applyNameRecords(ctx *Context, nameRecords []storeValue) []string {
+	names := []string{}
+
+	for _, nameRecordValue := range nameRecords {
+		name := string(nameRecordValue.key[len(ns.PrefixWRNToNameRecordIndex):])
+		ctx.cache.Set(nameRecordValue.key, nameRecordValue.value)
+		setProof(ctx, ctx.cache, nameRecordValue.key, nameRecordValue.proof)
 
 		// Update Record ID -> []Names index.
 		nameRecord := ns.GetNameRecord(ctx.cache, ctx.codec, name)
@@ -211,9 +555,45 @@ func (rpc *RPCNodeHandler) syncNameRecords(ctx *Context, height int64, names []s
 			// Delete name. ID of old record should be in history.
 			removeOldNameMapping(ctx, name, nameRecord)
 		}
+
+		names = append(names, name)
 	}
 
-	return nil
+	return names
+}
+
+// setProof saves the proof of the synced store value, so that it can be served to trustless clients (and lite peers).
+func setProof(ctx *Context, kvStore store.KVStore, key []byte, proof *Proof) {
+	if proof == nil {
+		return
+	}
+
+	kvStore.Set(GetProofIndexKey(key), ctx.codec.MustMarshalBinaryBare(*proof))
+}
+
+// setStoreValue saves the synced store value (deleted if absent) along with its proof.
+func setStoreValue(ctx *Context, kvStore store.KVStore, value storeValue) {
+	if value.value == nil {
+		kvStore.Delete(value.key)
+	} else {
+		kvStore.Set(value.key, value.value)
+	}
+
+	setProof(ctx, kvStore, value.key, value.proof)
+}
+
+// writeCache flushes the cached changes at the height to the underlying store, and saves their undo data.
+// Holds the store lock so that lite peers aren't served a value and proof from different heights.
+func writeCache(ctx *Context, height int64) {
+	ctx.storeLock.Lock()
+	defer ctx.storeLock.Unlock()
+
+	if ctx.config.UndoHeights > 0 {
+		ctx.undoStore.startRecording()
+	}
+
+	ctx.cache.Write()
+	saveUndoData(ctx, height, ctx.undoStore.stopRecording())
 }
 
 func removeOldNameMapping(ctx *Context, name string, nameRecord *ns.NameRecord) {
@@ -226,77 +606,182 @@ func removeOldNameMapping(ctx *Context, name string, nameRecord *ns.NameRecord)
 	}
 }
 
-func waitAfterSync(chainCurrentHeight int64, lastSyncedHeight int64) {
+func waitAfterSync(ctx *Context, chainCurrentHeight int64, lastSyncedHeight int64) {
 	if chainCurrentHeight == lastSyncedHeight {
-		// Caught up to current chain height, don't have to poll aggressively now.
-		time.Sleep(SyncIntervalInMillis * time.Millisecond)
+		// Caught up to current chain height, wait for the next block (or poll, if not subscribed to new blocks).
+		syncInterval := SyncIntervalInMillis * time.Millisecond
+		if isSubscribed(ctx) {
+			syncInterval = SubscribedSyncIntervalInMillis * time.Millisecond
+		}
+
+		select {
+		case <-ctx.newBlocks:
+		case <-time.After(syncInterval):
+		}
 	} else {
 		// Still catching up to current height, poll more aggressively.
 		time.Sleep(AggressiveSyncIntervalInMillis * time.Millisecond)
 	}
 }
 
+// logErrorAndWait logs the sync error and waits, with exponential backoff on consecutive errors.
 func logErrorAndWait(ctx *Context, err error) {
 	ctx.log.Errorln(err)
 
-	// TODO(ashwin): Exponential backoff logic.
-	time.Sleep(ErrorWaitDurationMillis * time.Millisecond)
+	ctx.syncErrors++
+	time.Sleep(getErrorWaitDuration(ctx.syncErrors))
 }
 
+// initFromNode imports the state from the primary node, verifying each value against the certified app hash.
+// The keys are listed using (unprovable) subspace queries, so they're cross-checked against other RPC nodes (see getSubspaceKeys).
 func initFromNode(ctx *Context) {
-	height, err := ctx.primaryNode.getCurrentHeight()
+	if len(ctx.config.CrossCheckNodes) == 0 {
+		ctx.log.Fatalln("Must pass `--cross-check-nodes` with `--from-node`, as the keys listed by the node can't be proven.")
+	}
+
+	currentHeight, err := getPrimaryNode(ctx).getCurrentHeight()
 	if err != nil {
 		ctx.log.Fatalln("Error fetching current height:", err)
 	}
 
-	ctx.log.Debugln("Current block height:", height)
+	ctx.log.Debugln("Current block height:", currentHeight)
+
+	// The AppHash for height H is in header H+1, so import the state at the previous height, which can be verified.
+	height := currentHeight - 1
+	if height <= 1 {
+		ctx.log.Fatalln("Chain height too low to verify state, retry later.")
+	}
+
+	filter := ctx.config.SyncFilter
+
+	recordKVs, recordProofs, err := ctx.getVerifiedSubspace(ns.StoreKey, ns.PrefixCIDToRecordIndex, height, func(kv storeTypes.KVPair) bool {
+		if filter.IsEmpty() {
+			return true
+		}
+
+		var record ns.RecordObj
+		ctx.codec.MustUnmarshalBinaryBare(kv.Value, &record)
 
-	recordKVs, err := ctx.getStoreSubspace("nameservice", ns.PrefixCIDToRecordIndex, height)
+		return filter.KeepRecord(record.ToRecord())
+	})
 	if err != nil {
 		ctx.log.Fatalln("Error fetching records", err)
 	}
 
-	for _, kv := range recordKVs {
+	for index, kv := range recordKVs {
 		var record ns.RecordObj
 		ctx.codec.MustUnmarshalBinaryBare(kv.Value, &record)
 		ctx.log.Debugln("Importing record", record.ID)
 		ctx.keeper.PutRecord(record)
+		ctx.keeper.SetProof(kv.Key, *recordProofs[index])
 	}
 
-	authorityKVs, err := ctx.getStoreSubspace("nameservice", ns.PrefixNameAuthorityRecordIndex, height)
+	authorityKVs, authorityProofs, err := ctx.getVerifiedSubspace(ns.StoreKey, ns.PrefixNameAuthorityRecordIndex, height, func(kv storeTypes.KVPair) bool {
+		return filter.KeepAuthority(string(kv.Key[len(ns.PrefixNameAuthorityRecordIndex):]))
+	})
 	if err != nil {
 		ctx.log.Fatalln("Error fetching authority records", err)
 	}
 
-	for _, kv := range authorityKVs {
+	for index, kv := range authorityKVs {
 		var authorityRecord ns.NameAuthority
 		ctx.codec.MustUnmarshalBinaryBare(kv.Value, &authorityRecord)
 		name := string(kv.Key[len(ns.PrefixNameAuthorityRecordIndex):])
 		ctx.log.Debugln("Importing authority", name)
 		ctx.keeper.SetNameAuthorityRecord(name, authorityRecord)
+		ctx.keeper.SetProof(kv.Key, *authorityProofs[index])
 	}
 
-	namesKVs, err := ctx.getStoreSubspace("nameservice", ns.PrefixWRNToNameRecordIndex, height)
+	namesKVs, nameProofs, err := ctx.getVerifiedSubspace(ns.StoreKey, ns.PrefixWRNToNameRecordIndex, height, func(kv storeTypes.KVPair) bool {
+		return filter.KeepName(string(kv.Key[len(ns.PrefixWRNToNameRecordIndex):]))
+	})
 	if err != nil {
 		ctx.log.Fatalln("Error fetching name records", err)
 	}
 
-	for _, kv := range namesKVs {
+	for index, kv := range namesKVs {
 		var nameRecord ns.NameRecord
 		ctx.codec.MustUnmarshalBinaryBare(kv.Value, &nameRecord)
 		wrn := string(kv.Key[len(ns.PrefixWRNToNameRecordIndex):])
 		ctx.log.Debugln("Importing name", wrn)
 
+		// Names must point to records that exist in the verified state, which also catches records omitted by the node.
+		// For partial sync, this imports records (outside the filter) referenced by kept names.
+		if nameRecord.ID != "" && !ctx.keeper.HasRecord(nameRecord.ID) {
+			importRecord(ctx, nameRecord.ID, height)
+		}
+
 		ctx.keeper.SetNameRecordRaw(wrn, nameRecord)
+		ctx.keeper.SetProof(kv.Key, *nameProofs[index])
 		if nameRecord.ID != "" {
 			ns.AddRecordToNameMapping(ctx.store, ctx.codec, nameRecord.ID, wrn)
 		}
 	}
 
+	err = importBonds(ctx, height)
+	if err != nil {
+		ctx.log.Fatalln("Error fetching bonds", err)
+	}
+
+	saveSyncFilter(ctx)
+
 	// Create sync status record.
 	ctx.keeper.SaveStatus(Status{LastSyncedHeight: height})
 }
 
+// importBonds imports the bonds (and their proofs) at the height, verifying each against the certified app hash.
+func importBonds(ctx *Context, height int64) error {
+	bondKVs, bondProofs, err := ctx.getVerifiedSubspace(bond.StoreKey, bond.PrefixIDToBondIndex, height, func(kv storeTypes.KVPair) bool {
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	for index, kv := range bondKVs {
+		var bondObj bond.Bond
+		ctx.codec.MustUnmarshalBinaryBare(kv.Value, &bondObj)
+		ctx.log.Debugln("Importing bond", bondObj.ID)
+		ctx.keeper.SaveBond(bondObj)
+		ctx.keeper.SetBondProof(kv.Key, *bondProofs[index])
+	}
+
+	return nil
+}
+
+// saveSyncFilter saves the sync filter (for partial sync), so that it's used on start.
+func saveSyncFilter(ctx *Context) {
+	if ctx.config.SyncFilter.IsEmpty() {
+		return
+	}
+
+	ctx.log.Infoln("Partial sync filter:", *ctx.config.SyncFilter)
+	ctx.keeper.SaveSyncFilter(*ctx.config.SyncFilter)
+}
+
+// importRecord imports a record (omitted from the subspace query, or filtered out) after verifying it against the certified app hash.
+func importRecord(ctx *Context, id ns.ID, height int64) {
+	recordKey := ns.GetRecordIndexKey(id)
+	value, proof, err := getPrimaryNode(ctx).getStoreValue(ctx, recordKey, height)
+	if err != nil {
+		ctx.log.Fatalln("Error fetching record", id, err)
+	}
+
+	if value == nil {
+		ctx.log.Fatalln("Record not found in verified state:", id)
+	}
+
+	var record ns.RecordObj
+	ctx.codec.MustUnmarshalBinaryBare(value, &record)
+	if ctx.config.SyncFilter.IsEmpty() {
+		ctx.log.Warnln("Importing record omitted by node", record.ID)
+	} else {
+		ctx.log.Debugln("Importing referenced record", record.ID)
+	}
+	ctx.keeper.PutRecord(record)
+	ctx.keeper.SetProof(recordKey, *proof)
+}
+
 func initFromGenesisFile(ctx *Context, height int64) {
 	// Create <home>/config directory if it doesn't exist.
 	configDirPath := filepath.Join(ctx.config.Home, "config")
@@ -325,36 +810,42 @@ func initFromGenesisFile(ctx *Context, height int64) {
 		ctx.log.Fatalln("Chain ID mismatch:", genesisJSONPath)
 	}
 
+	filter := ctx.config.SyncFilter
+
 	authorities := geneisState.AppState.Nameservice.Authorities
 	for _, nameAuthority := range authorities {
-		ctx.keeper.SetNameAuthorityRecord(nameAuthority.Name, nameAuthority.Entry)
+		if filter.KeepAuthority(nameAuthority.Name) {
+			ctx.keeper.SetNameAuthorityRecord(nameAuthority.Name, nameAuthority.Entry)
+		}
 	}
 
+	// Records referenced by kept names are imported irrespective of the filter.
+	referencedRecords := make(map[ns.ID]bool)
+
 	names := geneisState.AppState.Nameservice.Names
 	for _, nameEntry := range names {
-		ctx.keeper.SetNameRecord(nameEntry.Name, nameEntry.Entry)
+		if filter.KeepName(nameEntry.Name) {
+			ctx.keeper.SetNameRecord(nameEntry.Name, nameEntry.Entry)
+			referencedRecords[nameEntry.Entry.ID] = true
+		}
 	}
 
 	records := geneisState.AppState.Nameservice.Records
 	for _, record := range records {
-		ctx.keeper.PutRecord(record)
+		if referencedRecords[record.ID] || filter.KeepRecord(record.ToRecord()) {
+			ctx.keeper.PutRecord(record)
+		}
 	}
 
-	// Create sync status record.
-	ctx.keeper.SaveStatus(Status{LastSyncedHeight: height})
-}
-
-func getRandomRPCNodeHandler(ctx *Context) *RPCNodeHandler {
-	ctx.nodeLock.RLock()
-	defer ctx.nodeLock.RUnlock()
+	bonds := geneisState.AppState.Bond.Bonds
+	for _, bondObj := range bonds {
+		ctx.keeper.SaveBond(bondObj)
+	}
 
-	// TODO(ashwin): Intelligent selection of nodes (e.g. based on QoS).
-	nodes := ctx.secondaryNodes
-	keys := reflect.ValueOf(nodes).MapKeys()
-	address := keys[rand.Intn(len(keys))].Interface().(string)
-	rpc := nodes[address]
+	saveSyncFilter(ctx)
 
-	return rpc
+	// Create sync status record.
+	ctx.keeper.SaveStatus(Status{LastSyncedHeight: height})
 }
 
 func dumpConnectionStatsOnTimer(ctx *Context) {
@@ -380,39 +871,58 @@ func exitOnSyncTimeout(ctx *Context) {
 }
 
 func dumpConnectionStats(ctx *Context) {
-	ctx.nodeLock.RLock()
-	defer ctx.nodeLock.RUnlock()
+	// Log RPC node and lite peer stats.
+	bytes, _ := json.Marshal(ctx.GetRPCNodeStats())
+	ctx.log.Debugln(string(bytes))
 
-	// Log RPC node stats.
-	bytes, _ := json.Marshal(ctx.secondaryNodes)
+	bytes, _ = json.Marshal(ctx.GetPeerNodeStats())
 	ctx.log.Debugln(string(bytes))
 }
 
-func discoverRPCNodesOnTimer(ctx *Context) {
+func discoverRPCNodesOnTimer(ctx *Context, discoverers []Discoverer) {
+	// Endpoints last discovered by each discoverer, kept if discovery fails.
+	discovered := make([][]string, len(discoverers))
+
 	for {
-		discoverRPCNodes(ctx)
+		discoverRPCNodes(ctx, discoverers, discovered)
 		time.Sleep(DiscoverRPCNodesFrequencyMillis * time.Millisecond)
 	}
 }
 
-// Discover new RPC nodes.
-func discoverRPCNodes(ctx *Context) {
-	rpcEndpoints, err := DiscoverRPCEndpoints(ctx, ctx.config.Endpoint)
-	if err != nil {
-		ctx.log.Errorln("Error discovering RPC endpoints", err)
-		return
-	}
+// Discover RPC nodes, adding new ones and removing the ones no longer discovered (except the primary node).
+func discoverRPCNodes(ctx *Context, discoverers []Discoverer, discovered [][]string) {
+	rpcEndpoints := make(map[string]bool)
+
+	for index, discoverer := range discoverers {
+		endpoints, err := discoverer.Discover(ctx)
+		if err != nil {
+			ctx.log.Errorln("Error discovering RPC endpoints", discoverer.Name(), err)
+		} else {
+			ctx.log.Debugln("RPC endpoints:", discoverer.Name(), endpoints)
+			discovered[index] = endpoints
+		}
 
-	ctx.log.Debugln("RPC endpoints:", rpcEndpoints)
+		for _, rpcEndpoint := range discovered[index] {
+			rpcEndpoints[rpcEndpoint] = true
+		}
+	}
 
 	ctx.nodeLock.Lock()
 	defer ctx.nodeLock.Unlock()
 
-	for _, rpcEndpoint := range rpcEndpoints {
+	for rpcEndpoint := range rpcEndpoints {
 		if _, exists := ctx.secondaryNodes[rpcEndpoint]; !exists {
 			ctx.log.Infoln("Added new RPC endpoint:", rpcEndpoint)
 			rpc := NewRPCNodeHandler(rpcEndpoint)
 			ctx.secondaryNodes[rpcEndpoint] = rpc
 		}
 	}
+
+	for rpcEndpoint := range ctx.secondaryNodes {
+		// Note: Node lock is already held, so read the primary node directly.
+		if !rpcEndpoints[rpcEndpoint] && rpcEndpoint != ctx.primaryNode.Address {
+			ctx.log.Infoln("Removed RPC endpoint:", rpcEndpoint)
+			delete(ctx.secondaryNodes, rpcEndpoint)
+		}
+	}
 }
@@ -18,12 +18,14 @@ import (
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	dbm "github.com/tendermint/tm-db"
 	app "github.com/wirelineio/wns"
+	"github.com/wirelineio/wns/x/bond"
 	"github.com/wirelineio/wns/x/nameservice"
 )
 
 // AppState is used to import initial app state (records, names) into the db.
 type AppState struct {
 	Nameservice nameservice.GenesisState `json:"nameservice" yaml:"nameservice"`
+	Bond        bond.GenesisState        `json:"bond" yaml:"bond"`
 }
 
 // GenesisState is used to import initial state into the db.
@@ -40,10 +42,34 @@ type Config struct {
 	Home                string
 	InitFromNode        bool
 	InitFromGenesisFile bool
+	InitFromSnapshot    string
 	Endpoint            string
 	SyncTimeoutMins     int
+	SyncConcurrency     int
+
+	// Number of recent heights for which undo data is kept, for rollback (see undo.go). Zero disables undo data.
+	UndoHeights int64
+
+	// RPC endpoint discovery backends, besides WNS records (see discover.go).
+	DiscoveryRecordType    string
+	DiscoveryAttributePath string
+	EndpointsFile          string
+	DiscoveryDNSSRV        string
+
+	// RPC nodes the keys listed by the primary node are cross-checked against on init from node (see getSubspaceKeys).
+	CrossCheckNodes []string
+
+	// Lite peers used as secondary sources for sync, and the address to serve lite peers on (see peer.go).
+	Peers             []string
+	PeerListenAddress string
+
+	// Set at init for partial sync, and read from the db on start (see filter.go).
+	SyncFilter *SyncFilter
 }
 
+// DefaultSyncConcurrency is the default number of concurrent RPC requests (and heights fetched ahead) during sync.
+const DefaultSyncConcurrency = 4
+
 // RPCNodeHandler is used to call an RPC endpoint and maintains basic stats.
 type RPCNodeHandler struct {
 	Address      string          `json:"address"`
@@ -51,6 +77,21 @@ type RPCNodeHandler struct {
 	Calls        int64           `json:"calls"`
 	Errors       int64           `json:"errors"`
 	LastCalledAt time.Time       `json:"lastCalledAt"`
+
+	// Proofs (or responses) that failed verification, i.e. the node served bad data.
+	VerificationErrors int64 `json:"verificationErrors"`
+
+	// Moving averages used to score the node (see qos.go).
+	ErrorRate float64       `json:"errorRate"`
+	Latency   time.Duration `json:"latency"`
+
+	// Node isn't selected while quarantined, see quarantine().
+	QuarantinedUntil  time.Time `json:"quarantinedUntil"`
+	Quarantines       int       `json:"quarantines"`
+	ConsecutiveErrors int       `json:"-"`
+
+	// Mutex to update stats, as the node is called concurrently.
+	statsLock sync.Mutex
 }
 
 // NewRPCNodeHandler instantiates a new RPC node handler.
@@ -70,13 +111,16 @@ type Context struct {
 	config *Config
 	codec  *amino.Codec
 
-	// Primary RPC primaryNode, used for verification.
+	// Primary RPC primaryNode, used for verification. Can change on failover (see divergence.go), use getPrimaryNode.
 	primaryNode *RPCNodeHandler
 
 	// Other RPC secondaryNodes, used for load distribution.
 	secondaryNodes map[string]*RPCNodeHandler
 
-	// Mutex to read/write to secondaryNodes map.
+	// Lite peers, tried before RPC nodes for values they've already verified.
+	peerNodes map[string]*RPCNodeHandler
+
+	// Mutex to read/write to secondaryNodes and peerNodes maps.
 	nodeLock sync.RWMutex
 
 	log      *logrus.Logger
@@ -84,6 +128,51 @@ type Context struct {
 	store    store.KVStore
 	cache    *cachekv.Store
 	keeper   *Keeper
+
+	// Underlying store of the cache, records undo data for the changes flushed at each height (see undo.go).
+	undoStore *undoStore
+
+	// Held while flushing the cache, and while serving values (with proofs) to lite peers.
+	storeLock sync.RWMutex
+
+	// Limits the number of concurrent RPC requests during sync.
+	fetchSlots chan struct{}
+
+	// Number of consecutive sync errors, used for backoff.
+	syncErrors int
+
+	// Number of consecutive sync errors indicating divergence from the primary node (see divergence.go).
+	divergenceErrors int
+
+	// Notified on new blocks, if subscribed (see subscribe.go).
+	newBlocks  chan struct{}
+	subscribed int32
+
+	// Handlers called with each synced block changeset.
+	changesetHandlers []func(*nameservice.BlockChangeset)
+	handlerLock       sync.RWMutex
+}
+
+// Codec returns the codec (e.g. to decode txs).
+func (ctx *Context) Codec() *amino.Codec {
+	return ctx.codec
+}
+
+// OnChangeset registers a handler that's called with each synced block changeset.
+func (ctx *Context) OnChangeset(handler func(*nameservice.BlockChangeset)) {
+	ctx.handlerLock.Lock()
+	defer ctx.handlerLock.Unlock()
+
+	ctx.changesetHandlers = append(ctx.changesetHandlers, handler)
+}
+
+func (ctx *Context) notifyChangeset(changeset *nameservice.BlockChangeset) {
+	ctx.handlerLock.RLock()
+	defer ctx.handlerLock.RUnlock()
+
+	for _, handler := range ctx.changesetHandlers {
+		handler(changeset)
+	}
 }
 
 // NewContext creates a context object.
@@ -99,23 +188,37 @@ func NewContext(config *Config) *Context {
 
 	db := dbm.NewDB("graph", dbm.GoLevelDBBackend, filepath.Join(config.Home, "data"))
 	var dbStore store.KVStore = dbadapter.Store{DB: db}
-	cacheStore := cachekv.NewStore(dbStore)
+	recordingStore := &undoStore{KVStore: dbStore}
+	cacheStore := cachekv.NewStore(recordingStore)
 
 	codec := app.MakeCodec()
 
 	nodeAddress := config.NodeAddress
 
+	if config.SyncConcurrency < 1 {
+		config.SyncConcurrency = DefaultSyncConcurrency
+	}
+
 	ctx := Context{
 		config:         config,
 		codec:          codec,
 		store:          dbStore,
 		cache:          cacheStore,
+		undoStore:      recordingStore,
 		log:            log,
 		secondaryNodes: make(map[string]*RPCNodeHandler),
+		peerNodes:      make(map[string]*RPCNodeHandler),
+		fetchSlots:     make(chan struct{}, config.SyncConcurrency),
+		newBlocks:      make(chan struct{}, 1),
 	}
 
 	ctx.keeper = NewKeeper(&ctx)
 
+	// The filter can't change once the node is initialized, as state outside the earlier filter wouldn't have been synced.
+	if config.SyncFilter == nil {
+		config.SyncFilter = ctx.keeper.GetSyncFilter()
+	}
+
 	if nodeAddress != "" {
 		ctx.primaryNode = NewRPCNodeHandler(nodeAddress)
 
@@ -123,7 +226,17 @@ func NewContext(config *Config) *Context {
 		// Don't assume --endpoint flag will be passed for discovery of secondary nodes.
 		ctx.secondaryNodes[nodeAddress] = ctx.primaryNode
 
-		ctx.verifier = CreateVerifier(config)
+		ctx.verifier = CreateVerifier(&ctx)
+	}
+
+	for _, crossCheckAddress := range config.CrossCheckNodes {
+		if _, exists := ctx.secondaryNodes[crossCheckAddress]; !exists {
+			ctx.secondaryNodes[crossCheckAddress] = NewRPCNodeHandler(crossCheckAddress)
+		}
+	}
+
+	for _, peerAddress := range config.Peers {
+		ctx.peerNodes[peerAddress] = NewRPCNodeHandler(peerAddress)
 	}
 
 	return &ctx
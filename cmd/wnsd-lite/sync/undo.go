@@ -0,0 +1,152 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package sync
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ns "github.com/wirelineio/wns/x/nameservice"
+)
+
+// DefaultUndoHeights is the default number of recent heights for which undo data is kept, i.e. how far the node can be rolled back.
+const DefaultUndoHeights = 1000
+
+// UndoEntry is the value of a key before it was changed at a height (nil if the key didn't exist).
+type UndoEntry struct {
+	Key   []byte
+	Value []byte
+}
+
+// UndoData has the changes to revert to roll back the synced state at a height.
+type UndoData struct {
+	Height  int64
+	Entries []UndoEntry
+}
+
+// GetUndoDataIndexKey generates the height -> undo data index key.
+func GetUndoDataIndexKey(height int64) []byte {
+	return append(append([]byte{}, ns.PrefixHeightToUndoDataIndex...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// undoStore wraps the db store (under the sync cache), and records the previous values of the keys written to it.
+type undoStore struct {
+	store.KVStore
+
+	// Previous values of the keys written, while recording.
+	entries map[string][]byte
+}
+
+func (s *undoStore) Set(key []byte, value []byte) {
+	s.record(key)
+	s.KVStore.Set(key, value)
+}
+
+func (s *undoStore) Delete(key []byte) {
+	s.record(key)
+	s.KVStore.Delete(key)
+}
+
+func (s *undoStore) record(key []byte) {
+	if s.entries == nil {
+		return
+	}
+
+	if _, exists := s.entries[string(key)]; !exists {
+		s.entries[string(key)] = s.KVStore.Get(key)
+	}
+}
+
+// startRecording starts recording the previous values of keys written.
+func (s *undoStore) startRecording() {
+	s.entries = make(map[string][]byte)
+}
+
+// stopRecording stops recording, and returns the entries recorded (ordered by key).
+func (s *undoStore) stopRecording() []UndoEntry {
+	entries := []UndoEntry{}
+	for key, value := range s.entries {
+		entries = append(entries, UndoEntry{Key: []byte(key), Value: value})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return string(entries[i].Key) < string(entries[j].Key)
+	})
+
+	s.entries = nil
+
+	return entries
+}
+
+// saveUndoData saves the undo data for the height, and prunes undo data older than the undo window.
+// Must be called with the store lock held.
+func saveUndoData(ctx *Context, height int64, entries []UndoEntry) {
+	if ctx.config.UndoHeights <= 0 {
+		return
+	}
+
+	ctx.store.Set(GetUndoDataIndexKey(height), ctx.codec.MustMarshalBinaryBare(UndoData{Height: height, Entries: entries}))
+
+	pruneHeight := height - ctx.config.UndoHeights
+	if pruneHeight <= 0 {
+		return
+	}
+
+	// Iterate over the range, as the window may have been larger on earlier runs.
+	itr := ctx.store.Iterator(ns.PrefixHeightToUndoDataIndex, GetUndoDataIndexKey(pruneHeight+1))
+	keys := [][]byte{}
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, itr.Key())
+	}
+	itr.Close()
+
+	for _, key := range keys {
+		ctx.store.Delete(key)
+	}
+}
+
+// Rollback rewinds the synced state to the given height, using the undo data of the later heights.
+// Note: The node must be stopped. Sync resumes from the height on start.
+func Rollback(ctx *Context, height int64) error {
+	if !ctx.keeper.HasStatusRecord() {
+		return errors.New("node not initialized")
+	}
+
+	lastSyncedHeight := ctx.keeper.GetStatusRecord().LastSyncedHeight
+	if height >= lastSyncedHeight {
+		return fmt.Errorf("height must be lower than the last synced height %d", lastSyncedHeight)
+	}
+
+	// Check that all the undo data is available, before changing anything.
+	for undoHeight := lastSyncedHeight; undoHeight > height; undoHeight-- {
+		if !ctx.store.Has(GetUndoDataIndexKey(undoHeight)) {
+			return fmt.Errorf("undo data not found for height %d, the node can only be rolled back within the undo window", undoHeight)
+		}
+	}
+
+	for undoHeight := lastSyncedHeight; undoHeight > height; undoHeight-- {
+		var undoData UndoData
+		ctx.codec.MustUnmarshalBinaryBare(ctx.store.Get(GetUndoDataIndexKey(undoHeight)), &undoData)
+
+		for _, entry := range undoData.Entries {
+			if entry.Value == nil {
+				ctx.store.Delete(entry.Key)
+			} else {
+				ctx.store.Set(entry.Key, entry.Value)
+			}
+		}
+
+		ctx.store.Delete(GetUndoDataIndexKey(undoHeight))
+
+		// Saved after each height, so that an interrupted rollback can be resumed.
+		ctx.keeper.SaveStatus(Status{LastSyncedHeight: undoHeight - 1})
+		ctx.log.Infoln("Rolled back height:", undoHeight)
+	}
+
+	return nil
+}
@@ -24,19 +24,20 @@ import (
 
 // Note: Verifier code based on ~/go/pkg/mod/github.com/cosmos/cosmos-sdk@v0.37.0/client/context/query.go.
 
+// ErrInvalidProof is returned if the proof doesn't verify against the certified app hash, i.e. the node served bad data.
+var ErrInvalidProof = errors.New("failed to prove merkle proof")
+
 // TODO(ashwin): Determine appropriate cache size.
 const cacheSize = 10
 
-// CreateVerifier creates a light client verifier.
-func CreateVerifier(config *Config) tmlite.Verifier {
-	chainID := config.ChainID
-	home := config.Home
-	nodeAddress := config.NodeAddress
+// CreateVerifier creates a light client verifier, with the primary node as source (see primaryClient).
+func CreateVerifier(ctx *Context) tmlite.Verifier {
+	chainID := ctx.config.ChainID
+	home := ctx.config.Home
 
-	node := rpcclient.NewHTTP(nodeAddress, "/websocket")
 	verifier, err := tmliteProxy.NewVerifier(
 		chainID, filepath.Join(home, "data", ".lite_verifier"),
-		node, log.NewNopLogger(), cacheSize,
+		primaryClient{ctx: ctx}, log.NewNopLogger(), cacheSize,
 	)
 
 	if err != nil {
@@ -49,53 +50,78 @@ func CreateVerifier(config *Config) tmlite.Verifier {
 }
 
 // Verify verifies the consensus proof at given height.
+// Based on tmliteProxy.GetCertifiedCommit, but distinguishes commits that fail verification (see divergence.go).
 func Verify(ctx *Context, height int64) (tmtypes.SignedHeader, error) {
-	check, err := tmliteProxy.GetCertifiedCommit(height, ctx.primaryNode.Client, ctx.verifier)
+	client := getPrimaryNode(ctx).Client
+
+	rpcclient.WaitForHeight(client, height, nil)
+	res, err := client.Commit(&height)
+	if err != nil {
+		return tmtypes.SignedHeader{}, err
+	}
+
+	check := res.SignedHeader
+	if check.Height != height {
+		return tmtypes.SignedHeader{}, errors.Wrap(ErrInvalidCommit, fmt.Sprintf("height mismatch: want %d got %d", height, check.Height))
+	}
+
+	err = ctx.verifier.Verify(check)
 	switch {
 	case tmliteErr.IsErrCommitNotFound(err):
 		return tmtypes.SignedHeader{}, ErrVerifyCommit(height)
 	case err != nil:
-		return tmtypes.SignedHeader{}, err
+		return tmtypes.SignedHeader{}, errors.Wrap(ErrInvalidCommit, err.Error())
 	}
 
 	return check, nil
 }
 
-// VerifyProof verifies the ABCI response.
-func VerifyProof(ctx *Context, queryPath string, resp abci.ResponseQuery) error {
+// VerifyProof verifies the ABCI response, and returns the certified app hash the proof was verified against.
+func VerifyProof(ctx *Context, queryPath string, resp abci.ResponseQuery) ([]byte, error) {
 	if ctx.verifier == nil {
-		return fmt.Errorf("missing valid certifier to verify data from distrusted node")
+		return nil, fmt.Errorf("missing valid certifier to verify data from distrusted node")
 	}
 
 	// The AppHash for height H is in header H+1.
 	commit, err := Verify(ctx, resp.Height+1)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	prt := rootmulti.DefaultProofRuntime()
-
 	storeName, err := parseQueryStorePath(queryPath)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	err = verifyMerkleProof(storeName, resp.Key, resp.Value, resp.Proof, commit.Header.AppHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return commit.Header.AppHash, nil
+}
+
+// verifyMerkleProof verifies the value (or its absence, if nil) of the module store key against the app hash.
+func verifyMerkleProof(storeName string, key []byte, value []byte, proof *merkle.Proof, appHash []byte) error {
+	if proof == nil {
+		return errors.Wrap(ErrInvalidProof, "missing proof")
+	}
+
+	prt := rootmulti.DefaultProofRuntime()
+
 	kp := merkle.KeyPath{}
 	kp = kp.AppendKey([]byte(storeName), merkle.KeyEncodingURL)
-	kp = kp.AppendKey(resp.Key, merkle.KeyEncodingURL)
-
-	if resp.Value == nil {
-		err = prt.VerifyAbsence(resp.Proof, commit.Header.AppHash, kp.String())
-		if err != nil {
-			return errors.Wrap(err, "failed to prove merkle proof")
-		}
+	kp = kp.AppendKey(key, merkle.KeyEncodingURL)
 
-		return nil
+	var err error
+	if value == nil {
+		err = prt.VerifyAbsence(proof, appHash, kp.String())
+	} else {
+		err = prt.VerifyValue(proof, appHash, kp.String(), value)
 	}
 
-	err = prt.VerifyValue(resp.Proof, commit.Header.AppHash, kp.String(), resp.Value)
 	if err != nil {
-		return errors.Wrap(err, "failed to prove merkle proof")
+		return errors.Wrap(ErrInvalidProof, err.Error())
 	}
 
 	return nil
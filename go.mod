@@ -4,6 +4,7 @@ go 1.13
 
 require (
 	github.com/99designs/gqlgen v0.8.1
+	github.com/Masterminds/semver/v3 v3.1.1
 	github.com/cosmos/cosmos-sdk v0.37.0
 	github.com/deckarep/golang-set v1.7.1
 	github.com/ghodss/yaml v1.0.0
@@ -11,6 +12,7 @@ require (
 	github.com/go-chi/chi v3.3.2+incompatible
 	github.com/go-logfmt/logfmt v0.4.0 // indirect
 	github.com/gorilla/mux v1.7.0
+	github.com/gorilla/websocket v1.4.0
 	github.com/ipfs/go-cid v0.0.7 // indirect
 	github.com/ipfs/go-ipld-cbor v0.0.4
 	github.com/machinebox/graphql v0.2.2
@@ -0,0 +1,261 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package gql
+
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wirelineio/wns/x/nameservice"
+)
+
+// CreateTimeAttributeName denotes the record create time.
+const CreateTimeAttributeName = "createTime"
+
+// Time formats recognized when comparing string values.
+var timeFormats = []string{time.RFC3339Nano, sdk.SortableTimeFormat}
+
+// matchRecordFilter checks if a record matches all the attribute conditions and any of the OR groups (if present).
+func matchRecordFilter(record *nameservice.Record, filter *RecordFilterInput) bool {
+	for _, attr := range filter.Attributes {
+		if attr != nil && !matchAttributeFilter(record, attr) {
+			return false
+		}
+	}
+
+	if len(filter.Or) == 0 {
+		return true
+	}
+
+	for _, group := range filter.Or {
+		if group != nil && matchRecordFilter(record, group) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchAttributeFilter(record *nameservice.Record, attr *AttributeFilterInput) bool {
+	matched := false
+
+	recAttrVal, recAttrFound := getRecordValue(record, attr.Key)
+	if recAttrFound {
+		op := FilterOperatorEq
+		if attr.Op != nil {
+			op = *attr.Op
+		}
+
+		matched = matchValue(recAttrVal, op, &attr.Value)
+	}
+
+	if attr.Not != nil && *attr.Not {
+		return !matched
+	}
+
+	return matched
+}
+
+// getRecordValue gets the value to match against, from the record struct fields or the record attributes.
+func getRecordValue(record *nameservice.Record, key string) (interface{}, bool) {
+	switch key {
+	case BondIDAttributeName:
+		return record.GetBondID(), true
+	case ExpiryTimeAttributeName:
+		return record.GetExpiryTime(), true
+	case CreateTimeAttributeName:
+		return record.GetCreateTime(), true
+	}
+
+	value, ok := record.Attributes[key]
+
+	return value, ok
+}
+
+func matchValue(recAttrVal interface{}, op FilterOperator, value *ValueInput) bool {
+	switch op {
+	case FilterOperatorEq:
+		return valueEquals(recAttrVal, value)
+	case FilterOperatorGt, FilterOperatorGte, FilterOperatorLt, FilterOperatorLte:
+		result, ok := compareValue(recAttrVal, value)
+		if !ok {
+			return false
+		}
+
+		switch op {
+		case FilterOperatorGt:
+			return result > 0
+		case FilterOperatorGte:
+			return result >= 0
+		case FilterOperatorLt:
+			return result < 0
+		default:
+			return result <= 0
+		}
+	case FilterOperatorPrefix:
+		recAttrValString, ok := recAttrVal.(string)
+		return ok && value.String != nil && strings.HasPrefix(recAttrValString, *value.String)
+	case FilterOperatorContains:
+		recAttrValArray, ok := recAttrVal.([]interface{})
+		if !ok {
+			return false
+		}
+
+		for _, item := range recAttrValArray {
+			if valueEquals(item, value) {
+				return true
+			}
+		}
+	case FilterOperatorIn:
+		for _, item := range value.Values {
+			if item != nil && valueEquals(recAttrVal, item) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// valueEquals checks if the record value matches all the values set in the input.
+func valueEquals(recAttrVal interface{}, value *ValueInput) bool {
+	if value.Null != nil && *value.Null != (recAttrVal == nil) {
+		return false
+	}
+
+	if value.Int != nil {
+		recAttrValNumber, ok := getNumber(recAttrVal)
+		if !ok || float64(*value.Int) != recAttrValNumber {
+			return false
+		}
+	}
+
+	if value.Float != nil {
+		recAttrValNumber, ok := getNumber(recAttrVal)
+		if !ok || *value.Float != recAttrValNumber {
+			return false
+		}
+	}
+
+	if value.String != nil {
+		recAttrValString, ok := recAttrVal.(string)
+		if !ok || *value.String != recAttrValString {
+			return false
+		}
+	}
+
+	if value.Boolean != nil {
+		recAttrValBool, ok := recAttrVal.(bool)
+		if !ok || *value.Boolean != recAttrValBool {
+			return false
+		}
+	}
+
+	if value.Reference != nil {
+		recAttrValRefID, ok := getReferenceID(recAttrVal)
+		if !ok || recAttrValRefID != value.Reference.ID {
+			return false
+		}
+	}
+
+	if value.Values != nil {
+		recAttrValArray, ok := recAttrVal.([]interface{})
+		if !ok || len(recAttrValArray) != len(value.Values) {
+			return false
+		}
+
+		for index, item := range value.Values {
+			if item == nil || !valueEquals(recAttrValArray[index], item) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// compareValue compares a record value with the input (-1, 0 or 1), if they are comparable.
+// Numbers are compared numerically, strings as times if both are valid times, else lexicographically.
+func compareValue(recAttrVal interface{}, value *ValueInput) (int, bool) {
+	if value.Int != nil || value.Float != nil {
+		recAttrValNumber, ok := getNumber(recAttrVal)
+		if !ok {
+			return 0, false
+		}
+
+		number := value.Float
+		if number == nil {
+			intValue := float64(*value.Int)
+			number = &intValue
+		}
+
+		switch {
+		case recAttrValNumber < *number:
+			return -1, true
+		case recAttrValNumber > *number:
+			return 1, true
+		default:
+			return 0, true
+		}
+	}
+
+	if value.String != nil {
+		recAttrValString, ok := recAttrVal.(string)
+		if !ok {
+			return 0, false
+		}
+
+		recAttrValTime, recAttrValIsTime := parseTime(recAttrValString)
+		valueTime, valueIsTime := parseTime(*value.String)
+		if recAttrValIsTime && valueIsTime {
+			switch {
+			case recAttrValTime.Before(valueTime):
+				return -1, true
+			case recAttrValTime.After(valueTime):
+				return 1, true
+			default:
+				return 0, true
+			}
+		}
+
+		return strings.Compare(recAttrValString, *value.String), true
+	}
+
+	return 0, false
+}
+
+func getNumber(value interface{}) (float64, bool) {
+	switch val := value.(type) {
+	case float64:
+		return val, true
+	case int:
+		return float64(val), true
+	}
+
+	return 0, false
+}
+
+func getReferenceID(value interface{}) (string, bool) {
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		// Value is not an object.
+		return "", false
+	}
+
+	id, ok := obj["/"].(string)
+
+	return id, ok
+}
+
+func parseTime(value string) (time.Time, bool) {
+	for _, format := range timeFormats {
+		if t, err := time.Parse(format, value); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"io"
 	"strconv"
 	"sync"
 
@@ -34,7 +35,11 @@ type Config struct {
 
 type ResolverRoot interface {
 	Mutation() MutationResolver
+	NameRecord() NameRecordResolver
 	Query() QueryResolver
+	Record() RecordResolver
+	ResultMeta() ResultMetaResolver
+	Subscription() SubscriptionResolver
 }
 
 type DirectiveRoot struct {
@@ -49,10 +54,53 @@ type ComplexityRoot struct {
 		Balance  func(childComplexity int) int
 	}
 
-	AuthorityRecord struct {
+	Auction struct {
+		ID             func(childComplexity int) int
+		Status         func(childComplexity int) int
 		OwnerAddress   func(childComplexity int) int
-		OwnerPublicKey func(childComplexity int) int
-		Height         func(childComplexity int) int
+		CreateTime     func(childComplexity int) int
+		CommitsEndTime func(childComplexity int) int
+		RevealsEndTime func(childComplexity int) int
+		MinimumBid     func(childComplexity int) int
+		CommitDeposit  func(childComplexity int) int
+		WinnerAddress  func(childComplexity int) int
+		WinningBid     func(childComplexity int) int
+		WinningPrice   func(childComplexity int) int
+		Bids           func(childComplexity int) int
+	}
+
+	AuctionBid struct {
+		BidderAddress func(childComplexity int) int
+		Status        func(childComplexity int) int
+		CommitHash    func(childComplexity int) int
+		CommitTime    func(childComplexity int) int
+		RevealTime    func(childComplexity int) int
+		BidAmount     func(childComplexity int) int
+	}
+
+	AuthorityChange struct {
+		Height    func(childComplexity int) int
+		Name      func(childComplexity int) int
+		Authority func(childComplexity int) int
+	}
+
+	AuthorityDelegate struct {
+		Address     func(childComplexity int) int
+		Permissions func(childComplexity int) int
+	}
+
+	AuthorityRecord struct {
+		OwnerAddress        func(childComplexity int) int
+		OwnerPublicKey      func(childComplexity int) int
+		Height              func(childComplexity int) int
+		PendingOwnerAddress func(childComplexity int) int
+		BondID              func(childComplexity int) int
+		ExpiryTime          func(childComplexity int) int
+		Status              func(childComplexity int) int
+		AuctionID           func(childComplexity int) int
+		Owners              func(childComplexity int) int
+		Threshold           func(childComplexity int) int
+		Delegates           func(childComplexity int) int
 	}
 
 	AuthorityResult struct {
@@ -66,6 +114,11 @@ type ComplexityRoot struct {
 		Balance func(childComplexity int) int
 	}
 
+	BondResult struct {
+		Meta  func(childComplexity int) int
+		Bonds func(childComplexity int) int
+	}
+
 	Coin struct {
 		Type     func(childComplexity int) int
 		Quantity func(childComplexity int) int
@@ -78,12 +131,19 @@ type ComplexityRoot struct {
 
 	Mutation struct {
 		InsertRecord func(childComplexity int, attributes []*KeyValueInput) int
-		Submit       func(childComplexity int, tx string) int
+		Submit       func(childComplexity int, tx string, mode *BroadcastMode, waitForSync *bool) int
+	}
+
+	NameChange struct {
+		Height func(childComplexity int) int
+		Wrn    func(childComplexity int) int
+		Record func(childComplexity int) int
 	}
 
 	NameRecord struct {
 		Latest  func(childComplexity int) int
 		History func(childComplexity int) int
+		Proof   func(childComplexity int) int
 	}
 
 	NameRecordEntry struct {
@@ -108,28 +168,67 @@ type ComplexityRoot struct {
 		RemoteIP   func(childComplexity int) int
 	}
 
+	Proof struct {
+		Height  func(childComplexity int) int
+		AppHash func(childComplexity int) int
+		Key     func(childComplexity int) int
+		Value   func(childComplexity int) int
+		Ops     func(childComplexity int) int
+	}
+
+	ProofOp struct {
+		Type func(childComplexity int) int
+		Key  func(childComplexity int) int
+		Data func(childComplexity int) int
+	}
+
 	Query struct {
 		GetStatus         func(childComplexity int) int
 		GetLogs           func(childComplexity int, count *int) int
 		GetAccounts       func(childComplexity int, addresses []string) int
 		GetBondsByIds     func(childComplexity int, ids []string) int
 		QueryBonds        func(childComplexity int, attributes []*KeyValueInput) int
-		GetRecordsByIds   func(childComplexity int, ids []string) int
-		QueryRecords      func(childComplexity int, attributes []*KeyValueInput, all *bool) int
-		LookupAuthorities func(childComplexity int, names []string) int
-		LookupNames       func(childComplexity int, names []string) int
-		ResolveNames      func(childComplexity int, names []string) int
+		QueryBondsPage    func(childComplexity int, attributes []*KeyValueInput, limit *int, cursor *string) int
+		GetAuctionsByIds  func(childComplexity int, ids []string) int
+		GetRecordsByIds   func(childComplexity int, ids []string, height *string) int
+		GetRecordVersions func(childComplexity int, id string) int
+		QueryRecords      func(childComplexity int, attributes []*KeyValueInput, filter *RecordFilterInput, all *bool, height *string) int
+		QueryRecordsPage  func(childComplexity int, attributes []*KeyValueInput, filter *RecordFilterInput, all *bool, limit *int, cursor *string, height *string) int
+		LookupAuthorities func(childComplexity int, names []string, height *string) int
+		LookupNames       func(childComplexity int, names []string, height *string) int
+		ResolveNames      func(childComplexity int, names []string, height *string) int
+	}
+
+	RPCNodeInfo struct {
+		Address            func(childComplexity int) int
+		Calls              func(childComplexity int) int
+		Errors             func(childComplexity int) int
+		VerificationErrors func(childComplexity int) int
+		ErrorRate          func(childComplexity int) int
+		Latency            func(childComplexity int) int
+		Score              func(childComplexity int) int
+		QuarantinedUntil   func(childComplexity int) int
+		LastCalledAt       func(childComplexity int) int
 	}
 
 	Record struct {
-		ID         func(childComplexity int) int
-		Names      func(childComplexity int) int
-		BondID     func(childComplexity int) int
-		CreateTime func(childComplexity int) int
-		ExpiryTime func(childComplexity int) int
-		Owners     func(childComplexity int) int
-		Attributes func(childComplexity int) int
-		References func(childComplexity int) int
+		ID            func(childComplexity int) int
+		Names         func(childComplexity int) int
+		BondID        func(childComplexity int) int
+		CreateTime    func(childComplexity int) int
+		ExpiryTime    func(childComplexity int) int
+		Owners        func(childComplexity int) int
+		Attributes    func(childComplexity int) int
+		References    func(childComplexity int) int
+		PredecessorID func(childComplexity int) int
+		SuccessorID   func(childComplexity int) int
+		Proof         func(childComplexity int) int
+	}
+
+	RecordChange struct {
+		Height func(childComplexity int) int
+		ID     func(childComplexity int) int
+		Record func(childComplexity int) int
 	}
 
 	RecordResult struct {
@@ -142,7 +241,14 @@ type ComplexityRoot struct {
 	}
 
 	ResultMeta struct {
-		Height func(childComplexity int) int
+		Height     func(childComplexity int) int
+		NextCursor func(childComplexity int) int
+		Proof      func(childComplexity int) int
+	}
+
+	StateProof struct {
+		Height  func(childComplexity int) int
+		AppHash func(childComplexity int) int
 	}
 
 	Status struct {
@@ -154,6 +260,14 @@ type ComplexityRoot struct {
 		NumPeers   func(childComplexity int) int
 		Peers      func(childComplexity int) int
 		DiskUsage  func(childComplexity int) int
+		RPCNodes   func(childComplexity int) int
+		LitePeers  func(childComplexity int) int
+	}
+
+	Subscription struct {
+		OnNameChanged      func(childComplexity int, prefix *string) int
+		OnRecordChanged    func(childComplexity int, attributes []*KeyValueInput) int
+		OnAuthorityChanged func(childComplexity int, prefix *string) int
 	}
 
 	SyncInfo struct {
@@ -183,7 +297,10 @@ type ComplexityRoot struct {
 
 type MutationResolver interface {
 	InsertRecord(ctx context.Context, attributes []*KeyValueInput) (*Record, error)
-	Submit(ctx context.Context, tx string) (*string, error)
+	Submit(ctx context.Context, tx string, mode *BroadcastMode, waitForSync *bool) (*string, error)
+}
+type NameRecordResolver interface {
+	Proof(ctx context.Context, obj *NameRecord) (*Proof, error)
 }
 type QueryResolver interface {
 	GetStatus(ctx context.Context) (*Status, error)
@@ -191,11 +308,26 @@ type QueryResolver interface {
 	GetAccounts(ctx context.Context, addresses []string) ([]*Account, error)
 	GetBondsByIds(ctx context.Context, ids []string) ([]*Bond, error)
 	QueryBonds(ctx context.Context, attributes []*KeyValueInput) ([]*Bond, error)
-	GetRecordsByIds(ctx context.Context, ids []string) ([]*Record, error)
-	QueryRecords(ctx context.Context, attributes []*KeyValueInput, all *bool) ([]*Record, error)
-	LookupAuthorities(ctx context.Context, names []string) (*AuthorityResult, error)
-	LookupNames(ctx context.Context, names []string) (*NameResult, error)
-	ResolveNames(ctx context.Context, names []string) (*RecordResult, error)
+	QueryBondsPage(ctx context.Context, attributes []*KeyValueInput, limit *int, cursor *string) (*BondResult, error)
+	GetAuctionsByIds(ctx context.Context, ids []string) ([]*Auction, error)
+	GetRecordsByIds(ctx context.Context, ids []string, height *string) ([]*Record, error)
+	GetRecordVersions(ctx context.Context, id string) ([]*Record, error)
+	QueryRecords(ctx context.Context, attributes []*KeyValueInput, filter *RecordFilterInput, all *bool, height *string) ([]*Record, error)
+	QueryRecordsPage(ctx context.Context, attributes []*KeyValueInput, filter *RecordFilterInput, all *bool, limit *int, cursor *string, height *string) (*RecordResult, error)
+	LookupAuthorities(ctx context.Context, names []string, height *string) (*AuthorityResult, error)
+	LookupNames(ctx context.Context, names []string, height *string) (*NameResult, error)
+	ResolveNames(ctx context.Context, names []string, height *string) (*RecordResult, error)
+}
+type RecordResolver interface {
+	Proof(ctx context.Context, obj *Record) (*Proof, error)
+}
+type ResultMetaResolver interface {
+	Proof(ctx context.Context, obj *ResultMeta) (*StateProof, error)
+}
+type SubscriptionResolver interface {
+	OnNameChanged(ctx context.Context, prefix *string) (<-chan *NameChange, error)
+	OnRecordChanged(ctx context.Context, attributes []*KeyValueInput) (<-chan *RecordChange, error)
+	OnAuthorityChanged(ctx context.Context, prefix *string) (<-chan *AuthorityChange, error)
 }
 
 type executableSchema struct {
@@ -248,6 +380,167 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Account.Balance(childComplexity), true
 
+	case "Auction.ID":
+		if e.complexity.Auction.ID == nil {
+			break
+		}
+
+		return e.complexity.Auction.ID(childComplexity), true
+
+	case "Auction.Status":
+		if e.complexity.Auction.Status == nil {
+			break
+		}
+
+		return e.complexity.Auction.Status(childComplexity), true
+
+	case "Auction.OwnerAddress":
+		if e.complexity.Auction.OwnerAddress == nil {
+			break
+		}
+
+		return e.complexity.Auction.OwnerAddress(childComplexity), true
+
+	case "Auction.CreateTime":
+		if e.complexity.Auction.CreateTime == nil {
+			break
+		}
+
+		return e.complexity.Auction.CreateTime(childComplexity), true
+
+	case "Auction.CommitsEndTime":
+		if e.complexity.Auction.CommitsEndTime == nil {
+			break
+		}
+
+		return e.complexity.Auction.CommitsEndTime(childComplexity), true
+
+	case "Auction.RevealsEndTime":
+		if e.complexity.Auction.RevealsEndTime == nil {
+			break
+		}
+
+		return e.complexity.Auction.RevealsEndTime(childComplexity), true
+
+	case "Auction.MinimumBid":
+		if e.complexity.Auction.MinimumBid == nil {
+			break
+		}
+
+		return e.complexity.Auction.MinimumBid(childComplexity), true
+
+	case "Auction.CommitDeposit":
+		if e.complexity.Auction.CommitDeposit == nil {
+			break
+		}
+
+		return e.complexity.Auction.CommitDeposit(childComplexity), true
+
+	case "Auction.WinnerAddress":
+		if e.complexity.Auction.WinnerAddress == nil {
+			break
+		}
+
+		return e.complexity.Auction.WinnerAddress(childComplexity), true
+
+	case "Auction.WinningBid":
+		if e.complexity.Auction.WinningBid == nil {
+			break
+		}
+
+		return e.complexity.Auction.WinningBid(childComplexity), true
+
+	case "Auction.WinningPrice":
+		if e.complexity.Auction.WinningPrice == nil {
+			break
+		}
+
+		return e.complexity.Auction.WinningPrice(childComplexity), true
+
+	case "Auction.Bids":
+		if e.complexity.Auction.Bids == nil {
+			break
+		}
+
+		return e.complexity.Auction.Bids(childComplexity), true
+
+	case "AuctionBid.BidderAddress":
+		if e.complexity.AuctionBid.BidderAddress == nil {
+			break
+		}
+
+		return e.complexity.AuctionBid.BidderAddress(childComplexity), true
+
+	case "AuctionBid.Status":
+		if e.complexity.AuctionBid.Status == nil {
+			break
+		}
+
+		return e.complexity.AuctionBid.Status(childComplexity), true
+
+	case "AuctionBid.CommitHash":
+		if e.complexity.AuctionBid.CommitHash == nil {
+			break
+		}
+
+		return e.complexity.AuctionBid.CommitHash(childComplexity), true
+
+	case "AuctionBid.CommitTime":
+		if e.complexity.AuctionBid.CommitTime == nil {
+			break
+		}
+
+		return e.complexity.AuctionBid.CommitTime(childComplexity), true
+
+	case "AuctionBid.RevealTime":
+		if e.complexity.AuctionBid.RevealTime == nil {
+			break
+		}
+
+		return e.complexity.AuctionBid.RevealTime(childComplexity), true
+
+	case "AuctionBid.BidAmount":
+		if e.complexity.AuctionBid.BidAmount == nil {
+			break
+		}
+
+		return e.complexity.AuctionBid.BidAmount(childComplexity), true
+
+	case "AuthorityChange.Height":
+		if e.complexity.AuthorityChange.Height == nil {
+			break
+		}
+
+		return e.complexity.AuthorityChange.Height(childComplexity), true
+
+	case "AuthorityChange.Name":
+		if e.complexity.AuthorityChange.Name == nil {
+			break
+		}
+
+		return e.complexity.AuthorityChange.Name(childComplexity), true
+
+	case "AuthorityChange.Authority":
+		if e.complexity.AuthorityChange.Authority == nil {
+			break
+		}
+
+		return e.complexity.AuthorityChange.Authority(childComplexity), true
+
+	case "AuthorityDelegate.Address":
+		if e.complexity.AuthorityDelegate.Address == nil {
+			break
+		}
+
+		return e.complexity.AuthorityDelegate.Address(childComplexity), true
+
+	case "AuthorityDelegate.Permissions":
+		if e.complexity.AuthorityDelegate.Permissions == nil {
+			break
+		}
+
+		return e.complexity.AuthorityDelegate.Permissions(childComplexity), true
+
 	case "AuthorityRecord.OwnerAddress":
 		if e.complexity.AuthorityRecord.OwnerAddress == nil {
 			break
@@ -269,6 +562,62 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.AuthorityRecord.Height(childComplexity), true
 
+	case "AuthorityRecord.PendingOwnerAddress":
+		if e.complexity.AuthorityRecord.PendingOwnerAddress == nil {
+			break
+		}
+
+		return e.complexity.AuthorityRecord.PendingOwnerAddress(childComplexity), true
+
+	case "AuthorityRecord.BondID":
+		if e.complexity.AuthorityRecord.BondID == nil {
+			break
+		}
+
+		return e.complexity.AuthorityRecord.BondID(childComplexity), true
+
+	case "AuthorityRecord.ExpiryTime":
+		if e.complexity.AuthorityRecord.ExpiryTime == nil {
+			break
+		}
+
+		return e.complexity.AuthorityRecord.ExpiryTime(childComplexity), true
+
+	case "AuthorityRecord.Status":
+		if e.complexity.AuthorityRecord.Status == nil {
+			break
+		}
+
+		return e.complexity.AuthorityRecord.Status(childComplexity), true
+
+	case "AuthorityRecord.AuctionID":
+		if e.complexity.AuthorityRecord.AuctionID == nil {
+			break
+		}
+
+		return e.complexity.AuthorityRecord.AuctionID(childComplexity), true
+
+	case "AuthorityRecord.Owners":
+		if e.complexity.AuthorityRecord.Owners == nil {
+			break
+		}
+
+		return e.complexity.AuthorityRecord.Owners(childComplexity), true
+
+	case "AuthorityRecord.Threshold":
+		if e.complexity.AuthorityRecord.Threshold == nil {
+			break
+		}
+
+		return e.complexity.AuthorityRecord.Threshold(childComplexity), true
+
+	case "AuthorityRecord.Delegates":
+		if e.complexity.AuthorityRecord.Delegates == nil {
+			break
+		}
+
+		return e.complexity.AuthorityRecord.Delegates(childComplexity), true
+
 	case "AuthorityResult.Meta":
 		if e.complexity.AuthorityResult.Meta == nil {
 			break
@@ -304,6 +653,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Bond.Balance(childComplexity), true
 
+	case "BondResult.Meta":
+		if e.complexity.BondResult.Meta == nil {
+			break
+		}
+
+		return e.complexity.BondResult.Meta(childComplexity), true
+
+	case "BondResult.Bonds":
+		if e.complexity.BondResult.Bonds == nil {
+			break
+		}
+
+		return e.complexity.BondResult.Bonds(childComplexity), true
+
 	case "Coin.Type":
 		if e.complexity.Coin.Type == nil {
 			break
@@ -354,7 +717,28 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.Submit(childComplexity, args["tx"].(string)), true
+		return e.complexity.Mutation.Submit(childComplexity, args["tx"].(string), args["mode"].(*BroadcastMode), args["waitForSync"].(*bool)), true
+
+	case "NameChange.Height":
+		if e.complexity.NameChange.Height == nil {
+			break
+		}
+
+		return e.complexity.NameChange.Height(childComplexity), true
+
+	case "NameChange.Wrn":
+		if e.complexity.NameChange.Wrn == nil {
+			break
+		}
+
+		return e.complexity.NameChange.Wrn(childComplexity), true
+
+	case "NameChange.Record":
+		if e.complexity.NameChange.Record == nil {
+			break
+		}
+
+		return e.complexity.NameChange.Record(childComplexity), true
 
 	case "NameRecord.Latest":
 		if e.complexity.NameRecord.Latest == nil {
@@ -370,6 +754,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.NameRecord.History(childComplexity), true
 
+	case "NameRecord.Proof":
+		if e.complexity.NameRecord.Proof == nil {
+			break
+		}
+
+		return e.complexity.NameRecord.Proof(childComplexity), true
+
 	case "NameRecordEntry.ID":
 		if e.complexity.NameRecordEntry.ID == nil {
 			break
@@ -440,6 +831,62 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.PeerInfo.RemoteIP(childComplexity), true
 
+	case "Proof.Height":
+		if e.complexity.Proof.Height == nil {
+			break
+		}
+
+		return e.complexity.Proof.Height(childComplexity), true
+
+	case "Proof.AppHash":
+		if e.complexity.Proof.AppHash == nil {
+			break
+		}
+
+		return e.complexity.Proof.AppHash(childComplexity), true
+
+	case "Proof.Key":
+		if e.complexity.Proof.Key == nil {
+			break
+		}
+
+		return e.complexity.Proof.Key(childComplexity), true
+
+	case "Proof.Value":
+		if e.complexity.Proof.Value == nil {
+			break
+		}
+
+		return e.complexity.Proof.Value(childComplexity), true
+
+	case "Proof.Ops":
+		if e.complexity.Proof.Ops == nil {
+			break
+		}
+
+		return e.complexity.Proof.Ops(childComplexity), true
+
+	case "ProofOp.Type":
+		if e.complexity.ProofOp.Type == nil {
+			break
+		}
+
+		return e.complexity.ProofOp.Type(childComplexity), true
+
+	case "ProofOp.Key":
+		if e.complexity.ProofOp.Key == nil {
+			break
+		}
+
+		return e.complexity.ProofOp.Key(childComplexity), true
+
+	case "ProofOp.Data":
+		if e.complexity.ProofOp.Data == nil {
+			break
+		}
+
+		return e.complexity.ProofOp.Data(childComplexity), true
+
 	case "Query.GetStatus":
 		if e.complexity.Query.GetStatus == nil {
 			break
@@ -495,6 +942,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.QueryBonds(childComplexity, args["attributes"].([]*KeyValueInput)), true
 
+	case "Query.QueryBondsPage":
+		if e.complexity.Query.QueryBondsPage == nil {
+			break
+		}
+
+		args, err := ec.field_Query_queryBondsPage_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.QueryBondsPage(childComplexity, args["attributes"].([]*KeyValueInput), args["limit"].(*int), args["cursor"].(*string)), true
+
+	case "Query.GetAuctionsByIds":
+		if e.complexity.Query.GetAuctionsByIds == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getAuctionsByIds_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetAuctionsByIds(childComplexity, args["ids"].([]string)), true
+
 	case "Query.GetRecordsByIds":
 		if e.complexity.Query.GetRecordsByIds == nil {
 			break
@@ -505,7 +976,19 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.GetRecordsByIds(childComplexity, args["ids"].([]string)), true
+		return e.complexity.Query.GetRecordsByIds(childComplexity, args["ids"].([]string), args["height"].(*string)), true
+
+	case "Query.GetRecordVersions":
+		if e.complexity.Query.GetRecordVersions == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getRecordVersions_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetRecordVersions(childComplexity, args["id"].(string)), true
 
 	case "Query.QueryRecords":
 		if e.complexity.Query.QueryRecords == nil {
@@ -517,7 +1000,19 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.QueryRecords(childComplexity, args["attributes"].([]*KeyValueInput), args["all"].(*bool)), true
+		return e.complexity.Query.QueryRecords(childComplexity, args["attributes"].([]*KeyValueInput), args["filter"].(*RecordFilterInput), args["all"].(*bool), args["height"].(*string)), true
+
+	case "Query.QueryRecordsPage":
+		if e.complexity.Query.QueryRecordsPage == nil {
+			break
+		}
+
+		args, err := ec.field_Query_queryRecordsPage_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.QueryRecordsPage(childComplexity, args["attributes"].([]*KeyValueInput), args["filter"].(*RecordFilterInput), args["all"].(*bool), args["limit"].(*int), args["cursor"].(*string), args["height"].(*string)), true
 
 	case "Query.LookupAuthorities":
 		if e.complexity.Query.LookupAuthorities == nil {
@@ -529,7 +1024,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.LookupAuthorities(childComplexity, args["names"].([]string)), true
+		return e.complexity.Query.LookupAuthorities(childComplexity, args["names"].([]string), args["height"].(*string)), true
 
 	case "Query.LookupNames":
 		if e.complexity.Query.LookupNames == nil {
@@ -541,7 +1036,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.LookupNames(childComplexity, args["names"].([]string)), true
+		return e.complexity.Query.LookupNames(childComplexity, args["names"].([]string), args["height"].(*string)), true
 
 	case "Query.ResolveNames":
 		if e.complexity.Query.ResolveNames == nil {
@@ -553,38 +1048,101 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.ResolveNames(childComplexity, args["names"].([]string)), true
+		return e.complexity.Query.ResolveNames(childComplexity, args["names"].([]string), args["height"].(*string)), true
 
-	case "Record.ID":
-		if e.complexity.Record.ID == nil {
+	case "RPCNodeInfo.Address":
+		if e.complexity.RPCNodeInfo.Address == nil {
 			break
 		}
 
-		return e.complexity.Record.ID(childComplexity), true
+		return e.complexity.RPCNodeInfo.Address(childComplexity), true
 
-	case "Record.Names":
-		if e.complexity.Record.Names == nil {
+	case "RPCNodeInfo.Calls":
+		if e.complexity.RPCNodeInfo.Calls == nil {
 			break
 		}
 
-		return e.complexity.Record.Names(childComplexity), true
+		return e.complexity.RPCNodeInfo.Calls(childComplexity), true
 
-	case "Record.BondID":
-		if e.complexity.Record.BondID == nil {
+	case "RPCNodeInfo.Errors":
+		if e.complexity.RPCNodeInfo.Errors == nil {
 			break
 		}
 
-		return e.complexity.Record.BondID(childComplexity), true
+		return e.complexity.RPCNodeInfo.Errors(childComplexity), true
 
-	case "Record.CreateTime":
-		if e.complexity.Record.CreateTime == nil {
+	case "RPCNodeInfo.VerificationErrors":
+		if e.complexity.RPCNodeInfo.VerificationErrors == nil {
 			break
 		}
 
-		return e.complexity.Record.CreateTime(childComplexity), true
+		return e.complexity.RPCNodeInfo.VerificationErrors(childComplexity), true
 
-	case "Record.ExpiryTime":
-		if e.complexity.Record.ExpiryTime == nil {
+	case "RPCNodeInfo.ErrorRate":
+		if e.complexity.RPCNodeInfo.ErrorRate == nil {
+			break
+		}
+
+		return e.complexity.RPCNodeInfo.ErrorRate(childComplexity), true
+
+	case "RPCNodeInfo.Latency":
+		if e.complexity.RPCNodeInfo.Latency == nil {
+			break
+		}
+
+		return e.complexity.RPCNodeInfo.Latency(childComplexity), true
+
+	case "RPCNodeInfo.Score":
+		if e.complexity.RPCNodeInfo.Score == nil {
+			break
+		}
+
+		return e.complexity.RPCNodeInfo.Score(childComplexity), true
+
+	case "RPCNodeInfo.QuarantinedUntil":
+		if e.complexity.RPCNodeInfo.QuarantinedUntil == nil {
+			break
+		}
+
+		return e.complexity.RPCNodeInfo.QuarantinedUntil(childComplexity), true
+
+	case "RPCNodeInfo.LastCalledAt":
+		if e.complexity.RPCNodeInfo.LastCalledAt == nil {
+			break
+		}
+
+		return e.complexity.RPCNodeInfo.LastCalledAt(childComplexity), true
+
+	case "Record.ID":
+		if e.complexity.Record.ID == nil {
+			break
+		}
+
+		return e.complexity.Record.ID(childComplexity), true
+
+	case "Record.Names":
+		if e.complexity.Record.Names == nil {
+			break
+		}
+
+		return e.complexity.Record.Names(childComplexity), true
+
+	case "Record.BondID":
+		if e.complexity.Record.BondID == nil {
+			break
+		}
+
+		return e.complexity.Record.BondID(childComplexity), true
+
+	case "Record.CreateTime":
+		if e.complexity.Record.CreateTime == nil {
+			break
+		}
+
+		return e.complexity.Record.CreateTime(childComplexity), true
+
+	case "Record.ExpiryTime":
+		if e.complexity.Record.ExpiryTime == nil {
 			break
 		}
 
@@ -611,6 +1169,48 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Record.References(childComplexity), true
 
+	case "Record.PredecessorID":
+		if e.complexity.Record.PredecessorID == nil {
+			break
+		}
+
+		return e.complexity.Record.PredecessorID(childComplexity), true
+
+	case "Record.SuccessorID":
+		if e.complexity.Record.SuccessorID == nil {
+			break
+		}
+
+		return e.complexity.Record.SuccessorID(childComplexity), true
+
+	case "Record.Proof":
+		if e.complexity.Record.Proof == nil {
+			break
+		}
+
+		return e.complexity.Record.Proof(childComplexity), true
+
+	case "RecordChange.Height":
+		if e.complexity.RecordChange.Height == nil {
+			break
+		}
+
+		return e.complexity.RecordChange.Height(childComplexity), true
+
+	case "RecordChange.ID":
+		if e.complexity.RecordChange.ID == nil {
+			break
+		}
+
+		return e.complexity.RecordChange.ID(childComplexity), true
+
+	case "RecordChange.Record":
+		if e.complexity.RecordChange.Record == nil {
+			break
+		}
+
+		return e.complexity.RecordChange.Record(childComplexity), true
+
 	case "RecordResult.Meta":
 		if e.complexity.RecordResult.Meta == nil {
 			break
@@ -639,6 +1239,34 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ResultMeta.Height(childComplexity), true
 
+	case "ResultMeta.NextCursor":
+		if e.complexity.ResultMeta.NextCursor == nil {
+			break
+		}
+
+		return e.complexity.ResultMeta.NextCursor(childComplexity), true
+
+	case "ResultMeta.Proof":
+		if e.complexity.ResultMeta.Proof == nil {
+			break
+		}
+
+		return e.complexity.ResultMeta.Proof(childComplexity), true
+
+	case "StateProof.Height":
+		if e.complexity.StateProof.Height == nil {
+			break
+		}
+
+		return e.complexity.StateProof.Height(childComplexity), true
+
+	case "StateProof.AppHash":
+		if e.complexity.StateProof.AppHash == nil {
+			break
+		}
+
+		return e.complexity.StateProof.AppHash(childComplexity), true
+
 	case "Status.Version":
 		if e.complexity.Status.Version == nil {
 			break
@@ -695,6 +1323,56 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Status.DiskUsage(childComplexity), true
 
+	case "Status.RPCNodes":
+		if e.complexity.Status.RPCNodes == nil {
+			break
+		}
+
+		return e.complexity.Status.RPCNodes(childComplexity), true
+
+	case "Status.LitePeers":
+		if e.complexity.Status.LitePeers == nil {
+			break
+		}
+
+		return e.complexity.Status.LitePeers(childComplexity), true
+
+	case "Subscription.OnNameChanged":
+		if e.complexity.Subscription.OnNameChanged == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_onNameChanged_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.OnNameChanged(childComplexity, args["prefix"].(*string)), true
+
+	case "Subscription.OnRecordChanged":
+		if e.complexity.Subscription.OnRecordChanged == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_onRecordChanged_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.OnRecordChanged(childComplexity, args["attributes"].([]*KeyValueInput)), true
+
+	case "Subscription.OnAuthorityChanged":
+		if e.complexity.Subscription.OnAuthorityChanged == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_onAuthorityChanged_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.OnAuthorityChanged(childComplexity, args["prefix"].(*string)), true
+
 	case "SyncInfo.LatestBlockHash":
 		if e.complexity.SyncInfo.LatestBlockHash == nil {
 			break
@@ -839,7 +1517,36 @@ func (e *executableSchema) Mutation(ctx context.Context, op *ast.OperationDefini
 }
 
 func (e *executableSchema) Subscription(ctx context.Context, op *ast.OperationDefinition) func() *graphql.Response {
-	return graphql.OneShot(graphql.ErrorResponse(ctx, "subscriptions are not supported"))
+	ec := executionContext{graphql.GetRequestContext(ctx), e}
+
+	next := ec._Subscription(ctx, op.SelectionSet)
+	if ec.Errors != nil {
+		return graphql.OneShot(&graphql.Response{Data: []byte("null"), Errors: ec.Errors})
+	}
+
+	var buf bytes.Buffer
+	return func() *graphql.Response {
+		buf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {
+			buf.Reset()
+			data := next()
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+			return buf.Bytes()
+		})
+
+		if buf == nil {
+			return nil
+		}
+
+		return &graphql.Response{
+			Data:       buf,
+			Errors:     ec.Errors,
+			Extensions: ec.Extensions,
+		}
+	}
 }
 
 type executionContext struct {
@@ -932,6 +1639,39 @@ input KeyValueInput {
   value:      ValueInput!
 }
 
+# Transaction broadcast mode.
+enum BroadcastMode {
+  sync                        # Return the CheckTx result.
+  async                       # Return without waiting for CheckTx.
+  commit                      # Return the DeliverTx result, once committed in a block.
+}
+
+# Comparison operators for attribute filters.
+enum FilterOperator {
+  eq                          # Equal (default).
+  gt                          # Greater than (numbers, times, strings).
+  gte                         # Greater than or equal.
+  lt                          # Less than.
+  lte                         # Less than or equal.
+  prefix                      # String starts with the given string.
+  contains                    # Array contains the given value.
+  in                          # Value is one of the given ` + "`" + `values` + "`" + `.
+}
+
+# Attribute condition for record filters.
+input AttributeFilterInput {
+  key:        String!
+  op:         FilterOperator  # Defaults to ` + "`" + `eq` + "`" + `.
+  value:      ValueInput!     # For ` + "`" + `in` + "`" + `, the list of values to match against, in ` + "`" + `values` + "`" + `.
+  not:        Boolean         # Negate the condition.
+}
+
+# Record filter, i.e. (attributes[0] AND attributes[1] ...) AND (or[0] OR or[1] ...).
+input RecordFilterInput {
+  attributes: [AttributeFilterInput]
+  or:         [RecordFilterInput]
+}
+
 # Record defines the basic properties of an entity in the graph database.
 type Record {
   id:         String!         # Computed attribute: Multibase encoded content hash (https://github.com/multiformats/multibase).
@@ -944,11 +1684,40 @@ type Record {
   owners:     [String]!       # Addresses of record owners.
   attributes: [KeyValue]      # Record attributes.
   references: [Record]        # Record references.
+
+  predecessorId: String       # Previous version of the record (see ` + "`" + `supersedes` + "`" + ` attribute).
+  successorId:   String       # Next version of the record.
+
+  proof:      Proof           # Merkle proof of the record.
 }
 
 # Metadata for query results, e.g. chain height, proofs.
 type ResultMeta {
   height:     String!         # Block height.
+  nextCursor: String          # Cursor for the next page of results (paginated queries only, null on the last page).
+  proof:      StateProof      # State root at the result height (full nodes only).
+}
+
+# State root (app hash) at a block height, i.e. the root the merkle proofs verify against.
+type StateProof {
+  height:     String!         # Block height (the app hash is committed in the header at height + 1).
+  appHash:    String!         # App hash (hex).
+}
+
+# Merkle proof of a store value (or its absence) for trustless clients.
+type Proof {
+  height:     String!         # Block height of the state proven (verify against the app hash in the header at height + 1).
+  appHash:    String!         # App hash (hex) the proof resolves to.
+  key:        String!         # Store key (base64).
+  value:      String          # Store value (base64), null for proofs of absence.
+  ops:        [ProofOp!]!     # ABCI merkle proof operations.
+}
+
+# ABCI merkle proof operation.
+type ProofOp {
+  type:       String!         # Operation type, e.g. iavl:v, multistore.
+  key:        String!         # Key (base64).
+  data:       String!         # Operation data (base64).
 }
 
 # Name authority record.
@@ -956,6 +1725,20 @@ type AuthorityRecord {
   ownerAddress:     String!   # Owner address.
   ownerPublicKey:   String!   # Owner public key.
   height:           String!   # Height at which record was created.
+  pendingOwnerAddress: String # Address the authority is being transferred to, pending acceptance.
+  bondId:           String    # Bond used to pay authority rent.
+  expiryTime:       String    # Authority expiry time, unless rent is paid (not set if it never expires).
+  status:           String!   # Authority status (active/expired/auction).
+  auctionId:        String    # Auction for the name (top-level authorities only).
+  owners:           [String!] # Owner set, if the authority is controlled by multiple owners.
+  threshold:        Int       # Number of owner signatures required for changes (if there's an owner set).
+  delegates:        [AuthorityDelegate!] # Delegates with scoped write access.
+}
+
+# Address with scoped write access to a name authority.
+type AuthorityDelegate {
+  address:          String!   # Delegate address.
+  permissions:      [String!]! # Permissions, e.g. set-name, delete-name, reserve-authority.
 }
 
 # Name authority result, e.g. authority record + metadata.
@@ -970,6 +1753,12 @@ type RecordResult {
   records:    [Record]!
 }
 
+# Result for bond queries, i.e. bonds + metadata.
+type BondResult {
+  meta:       ResultMeta!
+  bonds:      [Bond]!
+}
+
 # Name record entry, created at a particular height.
 type NameRecordEntry {
   id:         String!         # Target record ID.
@@ -980,6 +1769,7 @@ type NameRecordEntry {
 type NameRecord {
   latest:     NameRecordEntry!     # Latest mame record entry.
   history:    [NameRecordEntry]    # Historical name record entries.
+  proof:      Proof                # Merkle proof of the name record.
 }
 
 # Name lookup result.
@@ -1012,6 +1802,32 @@ type Bond {
   balance:    [Coin!]         # Current balance for each coin type.
 }
 
+# Sealed bid, revealed after the commit phase.
+type AuctionBid {
+  bidderAddress:  String!     # Bidder cosmos-sdk address.
+  status:         String!     # Bid status (commit/reveal).
+  commitHash:     String!     # Hash of the sealed bid.
+  commitTime:     String!     # Bid commit time.
+  revealTime:     String      # Bid reveal time (not set until revealed).
+  bidAmount:      Coin        # Bid amount (not set until revealed).
+}
+
+# Sealed-bid (commit/reveal), second-price auction, e.g. for a top-level name.
+type Auction {
+  id:             String!     # Primary key, auto-generated by the server.
+  status:         String!     # Auction status (commit/reveal/completed).
+  ownerAddress:   String!     # Address of the account that started the auction.
+  createTime:     String!     # Auction create time.
+  commitsEndTime: String!     # End of the commit phase.
+  revealsEndTime: String!     # End of the reveal phase.
+  minimumBid:     Coin!       # Minimum bid.
+  commitDeposit:  Coin!       # Deposit paid to commit a bid (refunded on reveal, forfeited otherwise).
+  winnerAddress:  String      # Winner address (set at settlement, if there were valid bids).
+  winningBid:     Coin        # Winning bid amount.
+  winningPrice:   Coin        # Price paid by the winner (second highest bid or minimum bid).
+  bids:           [AuctionBid]! # Bids.
+}
+
 # Status information about a node (https://docs.tendermint.com/master/rpc/#/Info/status).
 type NodeInfo {
   id:         String!         # Tendermint Node ID.
@@ -1041,6 +1857,19 @@ type PeerInfo {
   remote_ip:      String!
 }
 
+# RPC node stats and QoS score (lite nodes only).
+type RPCNodeInfo {
+  address:              String!
+  calls:                String!
+  errors:               String!
+  verification_errors:  String!     # Responses that failed verification (e.g. invalid proofs).
+  error_rate:           Float!      # Moving average of errors.
+  latency:              String!     # Moving average of latency (e.g. 250ms).
+  score:                Float!      # QoS score in (0, 1], higher is better (used to select nodes).
+  quarantined_until:    String      # Node is not used for sync until then.
+  last_called_at:       String
+}
+
 # WNS status.
 type Status {
   version:    String!
@@ -1051,6 +1880,29 @@ type Status {
   num_peers:  String!
   peers:      [PeerInfo]
   disk_usage: String!
+  rpc_nodes:  [RPCNodeInfo]
+  lite_peers: [RPCNodeInfo]     # Lite nodes used as secondary sources for sync.
+}
+
+# Name (WRN) change notification.
+type NameChange {
+  height:     String!         # Block height of the change.
+  wrn:        String!         # Changed name.
+  record:     NameRecord      # Current name record.
+}
+
+# Record change notification.
+type RecordChange {
+  height:     String!         # Block height of the change.
+  id:         String!         # Changed record ID.
+  record:     Record          # Current record (null if deleted).
+}
+
+# Name authority change notification.
+type AuthorityChange {
+  height:     String!         # Block height of the change.
+  name:       String!         # Changed authority name.
+  authority:  AuthorityRecord # Current authority record.
 }
 
 type Query {
@@ -1087,6 +1939,27 @@ type Query {
     attributes: [KeyValueInput]
   ): [Bond]
 
+  # Query bonds, a page at a time.
+  queryBondsPage(
+    # Multiple attribute conditions are in a logical AND.
+    attributes: [KeyValueInput]
+
+    # Max number of bonds to return (all, by default).
+    limit: Int
+
+    # Cursor returned by the previous page (` + "`" + `meta.nextCursor` + "`" + `).
+    cursor: String
+  ): BondResult!
+
+  #
+  # Auction API.
+  #
+
+  # Get auctions by IDs.
+  getAuctionsByIds(
+    ids: [String!]
+  ): [Auction]
+
   #
   # GraphDB API.
   #
@@ -1094,6 +1967,14 @@ type Query {
   # Get records by IDs.
   getRecordsByIds(
     ids: [String!]
+
+    # Block height to query at (latest, by default). Full nodes only, subject to pruning.
+    height: String
+  ): [Record]
+
+  # Get the version chain (oldest first) of a record.
+  getRecordVersions(
+    id: String!
   ): [Record]
 
   # Query records.
@@ -1101,10 +1982,37 @@ type Query {
     # Multiple attribute conditions are in a logical AND.
     attributes: [KeyValueInput]
 
+    # Filter with operators and OR groups, in a logical AND with ` + "`" + `attributes` + "`" + `.
+    filter: RecordFilterInput
+
     # Whether to query all records, not just named ones (false by default).
     all: Boolean
+
+    # Block height to query at (latest, by default). Full nodes only, subject to pruning.
+    height: String
   ): [Record]
 
+  # Query records, a page at a time.
+  queryRecordsPage(
+    # Multiple attribute conditions are in a logical AND.
+    attributes: [KeyValueInput]
+
+    # Filter with operators and OR groups, in a logical AND with ` + "`" + `attributes` + "`" + `.
+    filter: RecordFilterInput
+
+    # Whether to query all records, not just named ones (false by default).
+    all: Boolean
+
+    # Max number of records to return (all, by default).
+    limit: Int
+
+    # Cursor returned by the previous page (` + "`" + `meta.nextCursor` + "`" + `).
+    cursor: String
+
+    # Block height to query at (latest, by default). Full nodes only, subject to pruning.
+    height: String
+  ): RecordResult!
+
   #
   # Naming API.
   #
@@ -1112,16 +2020,25 @@ type Query {
   # Lookup authority information.
   lookupAuthorities(
     names: [String!]
+
+    # Block height to query at (latest, by default). Full nodes only, subject to pruning.
+    height: String
   ): AuthorityResult!
 
   # Lookup name to record mapping information.
   lookupNames(
     names: [String!]
+
+    # Block height to query at (latest, by default). Full nodes only, subject to pruning.
+    height: String
   ): NameResult!
 
   # Resolve names to records.
   resolveNames(
     names: [String!]
+
+    # Block height to query at (latest, by default). Full nodes only, subject to pruning.
+    height: String
   ): RecordResult!
 }
 
@@ -1134,7 +2051,37 @@ type Mutation {
 
   # Submit a transaction to the blockchain.
   # ` + "`" + `tx` + "`" + ` is a blob created by https://github.com/wirelineio/registry-client.
-  submit(tx: String!): String
+  # Lite nodes forward the transaction to a full-node.
+  submit(
+    tx: String!
+
+    # Broadcast mode (commit, by default).
+    mode: BroadcastMode
+
+    # Lite nodes only: Wait until the node has synced the block with the transaction.
+    waitForSync: Boolean
+  ): String
+}
+
+type Subscription {
+
+  # Notify name changes (e.g. a WRN pointing to a new record).
+  onNameChanged(
+    # Only notify names with the prefix, e.g. ` + "`" + `wrn://wireline.io/` + "`" + `.
+    prefix: String
+  ): NameChange!
+
+  # Notify record changes (e.g. new, renewed or deleted records).
+  onRecordChanged(
+    # Only notify records matching all the attribute conditions.
+    attributes: [KeyValueInput]
+  ): RecordChange!
+
+  # Notify name authority changes (e.g. reserved, transferred or expired authorities).
+  onAuthorityChanged(
+    # Only notify authorities with the prefix.
+    prefix: String
+  ): AuthorityChange!
 }
 `},
 )
@@ -1168,6 +2115,22 @@ func (ec *executionContext) field_Mutation_submit_args(ctx context.Context, rawA
 		}
 	}
 	args["tx"] = arg0
+	var arg1 *BroadcastMode
+	if tmp, ok := rawArgs["mode"]; ok {
+		arg1, err = ec.unmarshalOBroadcastMode2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐBroadcastMode(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["mode"] = arg1
+	var arg2 *bool
+	if tmp, ok := rawArgs["waitForSync"]; ok {
+		arg2, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["waitForSync"] = arg2
 	return args, nil
 }
 
@@ -1199,6 +2162,20 @@ func (ec *executionContext) field_Query_getAccounts_args(ctx context.Context, ra
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_getAuctionsByIds_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 []string
+	if tmp, ok := rawArgs["ids"]; ok {
+		arg0, err = ec.unmarshalOString2ᚕstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["ids"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_getBondsByIds_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1227,6 +2204,20 @@ func (ec *executionContext) field_Query_getLogs_args(ctx context.Context, rawArg
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_getRecordVersions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_getRecordsByIds_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1238,6 +2229,14 @@ func (ec *executionContext) field_Query_getRecordsByIds_args(ctx context.Context
 		}
 	}
 	args["ids"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["height"]; ok {
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["height"] = arg1
 	return args, nil
 }
 
@@ -1252,6 +2251,14 @@ func (ec *executionContext) field_Query_lookupAuthorities_args(ctx context.Conte
 		}
 	}
 	args["names"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["height"]; ok {
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["height"] = arg1
 	return args, nil
 }
 
@@ -1266,24 +2273,18 @@ func (ec *executionContext) field_Query_lookupNames_args(ctx context.Context, ra
 		}
 	}
 	args["names"] = arg0
-	return args, nil
-}
-
-func (ec *executionContext) field_Query_queryBonds_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 []*KeyValueInput
-	if tmp, ok := rawArgs["attributes"]; ok {
-		arg0, err = ec.unmarshalOKeyValueInput2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐKeyValueInput(ctx, tmp)
+	var arg1 *string
+	if tmp, ok := rawArgs["height"]; ok {
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["attributes"] = arg0
+	args["height"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_queryRecords_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_queryBondsPage_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 []*KeyValueInput
@@ -1294,16 +2295,130 @@ func (ec *executionContext) field_Query_queryRecords_args(ctx context.Context, r
 		}
 	}
 	args["attributes"] = arg0
-	var arg1 *bool
-	if tmp, ok := rawArgs["all"]; ok {
-		arg1, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+	var arg1 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["all"] = arg1
-	return args, nil
-}
+	args["limit"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["cursor"]; ok {
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["cursor"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_queryBonds_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 []*KeyValueInput
+	if tmp, ok := rawArgs["attributes"]; ok {
+		arg0, err = ec.unmarshalOKeyValueInput2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐKeyValueInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["attributes"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_queryRecordsPage_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 []*KeyValueInput
+	if tmp, ok := rawArgs["attributes"]; ok {
+		arg0, err = ec.unmarshalOKeyValueInput2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐKeyValueInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["attributes"] = arg0
+	var arg1 *RecordFilterInput
+	if tmp, ok := rawArgs["filter"]; ok {
+		arg1, err = ec.unmarshalORecordFilterInput2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecordFilterInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filter"] = arg1
+	var arg2 *bool
+	if tmp, ok := rawArgs["all"]; ok {
+		arg2, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["all"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["cursor"]; ok {
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["cursor"] = arg4
+	var arg5 *string
+	if tmp, ok := rawArgs["height"]; ok {
+		arg5, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["height"] = arg5
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_queryRecords_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 []*KeyValueInput
+	if tmp, ok := rawArgs["attributes"]; ok {
+		arg0, err = ec.unmarshalOKeyValueInput2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐKeyValueInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["attributes"] = arg0
+	var arg1 *RecordFilterInput
+	if tmp, ok := rawArgs["filter"]; ok {
+		arg1, err = ec.unmarshalORecordFilterInput2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecordFilterInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filter"] = arg1
+	var arg2 *bool
+	if tmp, ok := rawArgs["all"]; ok {
+		arg2, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["all"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["height"]; ok {
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["height"] = arg3
+	return args, nil
+}
 
 func (ec *executionContext) field_Query_resolveNames_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
@@ -1316,6 +2431,56 @@ func (ec *executionContext) field_Query_resolveNames_args(ctx context.Context, r
 		}
 	}
 	args["names"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["height"]; ok {
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["height"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_onAuthorityChanged_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["prefix"]; ok {
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["prefix"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_onNameChanged_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["prefix"]; ok {
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["prefix"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_onRecordChanged_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 []*KeyValueInput
+	if tmp, ok := rawArgs["attributes"]; ok {
+		arg0, err = ec.unmarshalOKeyValueInput2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐKeyValueInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["attributes"] = arg0
 	return args, nil
 }
 
@@ -1475,11 +2640,11 @@ func (ec *executionContext) _Account_balance(ctx context.Context, field graphql.
 	return ec.marshalOCoin2ᚕgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐCoin(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _AuthorityRecord_ownerAddress(ctx context.Context, field graphql.CollectedField, obj *AuthorityRecord) graphql.Marshaler {
+func (ec *executionContext) _Auction_id(ctx context.Context, field graphql.CollectedField, obj *Auction) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "AuthorityRecord",
+		Object: "Auction",
 		Field:  field,
 		Args:   nil,
 	}
@@ -1487,7 +2652,7 @@ func (ec *executionContext) _AuthorityRecord_ownerAddress(ctx context.Context, f
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OwnerAddress, nil
+		return obj.ID, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -1501,11 +2666,11 @@ func (ec *executionContext) _AuthorityRecord_ownerAddress(ctx context.Context, f
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _AuthorityRecord_ownerPublicKey(ctx context.Context, field graphql.CollectedField, obj *AuthorityRecord) graphql.Marshaler {
+func (ec *executionContext) _Auction_status(ctx context.Context, field graphql.CollectedField, obj *Auction) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "AuthorityRecord",
+		Object: "Auction",
 		Field:  field,
 		Args:   nil,
 	}
@@ -1513,7 +2678,7 @@ func (ec *executionContext) _AuthorityRecord_ownerPublicKey(ctx context.Context,
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OwnerPublicKey, nil
+		return obj.Status, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -1527,11 +2692,11 @@ func (ec *executionContext) _AuthorityRecord_ownerPublicKey(ctx context.Context,
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _AuthorityRecord_height(ctx context.Context, field graphql.CollectedField, obj *AuthorityRecord) graphql.Marshaler {
+func (ec *executionContext) _Auction_ownerAddress(ctx context.Context, field graphql.CollectedField, obj *Auction) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "AuthorityRecord",
+		Object: "Auction",
 		Field:  field,
 		Args:   nil,
 	}
@@ -1539,7 +2704,7 @@ func (ec *executionContext) _AuthorityRecord_height(ctx context.Context, field g
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Height, nil
+		return obj.OwnerAddress, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -1553,11 +2718,11 @@ func (ec *executionContext) _AuthorityRecord_height(ctx context.Context, field g
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _AuthorityResult_meta(ctx context.Context, field graphql.CollectedField, obj *AuthorityResult) graphql.Marshaler {
+func (ec *executionContext) _Auction_createTime(ctx context.Context, field graphql.CollectedField, obj *Auction) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "AuthorityResult",
+		Object: "Auction",
 		Field:  field,
 		Args:   nil,
 	}
@@ -1565,7 +2730,7 @@ func (ec *executionContext) _AuthorityResult_meta(ctx context.Context, field gra
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Meta, nil
+		return obj.CreateTime, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -1573,17 +2738,17 @@ func (ec *executionContext) _AuthorityResult_meta(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(ResultMeta)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNResultMeta2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐResultMeta(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _AuthorityResult_records(ctx context.Context, field graphql.CollectedField, obj *AuthorityResult) graphql.Marshaler {
+func (ec *executionContext) _Auction_commitsEndTime(ctx context.Context, field graphql.CollectedField, obj *Auction) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "AuthorityResult",
+		Object: "Auction",
 		Field:  field,
 		Args:   nil,
 	}
@@ -1591,7 +2756,7 @@ func (ec *executionContext) _AuthorityResult_records(ctx context.Context, field
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Records, nil
+		return obj.CommitsEndTime, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -1599,17 +2764,17 @@ func (ec *executionContext) _AuthorityResult_records(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*AuthorityRecord)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNAuthorityRecord2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAuthorityRecord(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Bond_id(ctx context.Context, field graphql.CollectedField, obj *Bond) graphql.Marshaler {
+func (ec *executionContext) _Auction_revealsEndTime(ctx context.Context, field graphql.CollectedField, obj *Auction) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "Bond",
+		Object: "Auction",
 		Field:  field,
 		Args:   nil,
 	}
@@ -1617,7 +2782,7 @@ func (ec *executionContext) _Bond_id(ctx context.Context, field graphql.Collecte
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.RevealsEndTime, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -1631,11 +2796,11 @@ func (ec *executionContext) _Bond_id(ctx context.Context, field graphql.Collecte
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Bond_owner(ctx context.Context, field graphql.CollectedField, obj *Bond) graphql.Marshaler {
+func (ec *executionContext) _Auction_minimumBid(ctx context.Context, field graphql.CollectedField, obj *Auction) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "Bond",
+		Object: "Auction",
 		Field:  field,
 		Args:   nil,
 	}
@@ -1643,7 +2808,7 @@ func (ec *executionContext) _Bond_owner(ctx context.Context, field graphql.Colle
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Owner, nil
+		return obj.MinimumBid, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -1651,17 +2816,17 @@ func (ec *executionContext) _Bond_owner(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(Coin)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNCoin2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐCoin(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Bond_balance(ctx context.Context, field graphql.CollectedField, obj *Bond) graphql.Marshaler {
+func (ec *executionContext) _Auction_commitDeposit(ctx context.Context, field graphql.CollectedField, obj *Auction) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "Bond",
+		Object: "Auction",
 		Field:  field,
 		Args:   nil,
 	}
@@ -1669,22 +2834,25 @@ func (ec *executionContext) _Bond_balance(ctx context.Context, field graphql.Col
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Balance, nil
+		return obj.CommitDeposit, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]Coin)
+	res := resTmp.(Coin)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOCoin2ᚕgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐCoin(ctx, field.Selections, res)
+	return ec.marshalNCoin2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐCoin(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Coin_type(ctx context.Context, field graphql.CollectedField, obj *Coin) graphql.Marshaler {
+func (ec *executionContext) _Auction_winnerAddress(ctx context.Context, field graphql.CollectedField, obj *Auction) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "Coin",
+		Object: "Auction",
 		Field:  field,
 		Args:   nil,
 	}
@@ -1692,25 +2860,22 @@ func (ec *executionContext) _Coin_type(ctx context.Context, field graphql.Collec
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.WinnerAddress, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Coin_quantity(ctx context.Context, field graphql.CollectedField, obj *Coin) graphql.Marshaler {
+func (ec *executionContext) _Auction_winningBid(ctx context.Context, field graphql.CollectedField, obj *Auction) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "Coin",
+		Object: "Auction",
 		Field:  field,
 		Args:   nil,
 	}
@@ -1718,25 +2883,22 @@ func (ec *executionContext) _Coin_quantity(ctx context.Context, field graphql.Co
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Quantity, nil
+		return obj.WinningBid, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*Coin)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOCoin2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐCoin(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KeyValue_key(ctx context.Context, field graphql.CollectedField, obj *KeyValue) graphql.Marshaler {
+func (ec *executionContext) _Auction_winningPrice(ctx context.Context, field graphql.CollectedField, obj *Auction) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "KeyValue",
+		Object: "Auction",
 		Field:  field,
 		Args:   nil,
 	}
@@ -1744,25 +2906,22 @@ func (ec *executionContext) _KeyValue_key(ctx context.Context, field graphql.Col
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Key, nil
+		return obj.WinningPrice, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*Coin)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOCoin2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐCoin(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KeyValue_value(ctx context.Context, field graphql.CollectedField, obj *KeyValue) graphql.Marshaler {
+func (ec *executionContext) _Auction_bids(ctx context.Context, field graphql.CollectedField, obj *Auction) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "KeyValue",
+		Object: "Auction",
 		Field:  field,
 		Args:   nil,
 	}
@@ -1770,7 +2929,7 @@ func (ec *executionContext) _KeyValue_value(ctx context.Context, field graphql.C
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return obj.Bids, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -1778,77 +2937,69 @@ func (ec *executionContext) _KeyValue_value(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(Value)
+	res := resTmp.([]*AuctionBid)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNValue2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐValue(ctx, field.Selections, res)
+	return ec.marshalNAuctionBid2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAuctionBid(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_insertRecord(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _AuctionBid_bidderAddress(ctx context.Context, field graphql.CollectedField, obj *AuctionBid) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "Mutation",
+		Object: "AuctionBid",
 		Field:  field,
 		Args:   nil,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_insertRecord_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().InsertRecord(rctx, args["attributes"].([]*KeyValueInput))
+		return obj.BidderAddress, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*Record)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalORecord2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecord(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_submit(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _AuctionBid_status(ctx context.Context, field graphql.CollectedField, obj *AuctionBid) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "Mutation",
+		Object: "AuctionBid",
 		Field:  field,
 		Args:   nil,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_submit_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().Submit(rctx, args["tx"].(string))
+		return obj.Status, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _NameRecord_latest(ctx context.Context, field graphql.CollectedField, obj *NameRecord) graphql.Marshaler {
+func (ec *executionContext) _AuctionBid_commitHash(ctx context.Context, field graphql.CollectedField, obj *AuctionBid) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "NameRecord",
+		Object: "AuctionBid",
 		Field:  field,
 		Args:   nil,
 	}
@@ -1856,7 +3007,7 @@ func (ec *executionContext) _NameRecord_latest(ctx context.Context, field graphq
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Latest, nil
+		return obj.CommitHash, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -1864,17 +3015,17 @@ func (ec *executionContext) _NameRecord_latest(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(NameRecordEntry)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNNameRecordEntry2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐNameRecordEntry(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _NameRecord_history(ctx context.Context, field graphql.CollectedField, obj *NameRecord) graphql.Marshaler {
+func (ec *executionContext) _AuctionBid_commitTime(ctx context.Context, field graphql.CollectedField, obj *AuctionBid) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "NameRecord",
+		Object: "AuctionBid",
 		Field:  field,
 		Args:   nil,
 	}
@@ -1882,22 +3033,25 @@ func (ec *executionContext) _NameRecord_history(ctx context.Context, field graph
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.History, nil
+		return obj.CommitTime, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*NameRecordEntry)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalONameRecordEntry2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐNameRecordEntry(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _NameRecordEntry_id(ctx context.Context, field graphql.CollectedField, obj *NameRecordEntry) graphql.Marshaler {
+func (ec *executionContext) _AuctionBid_revealTime(ctx context.Context, field graphql.CollectedField, obj *AuctionBid) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "NameRecordEntry",
+		Object: "AuctionBid",
 		Field:  field,
 		Args:   nil,
 	}
@@ -1905,25 +3059,22 @@ func (ec *executionContext) _NameRecordEntry_id(ctx context.Context, field graph
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.RevealTime, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _NameRecordEntry_height(ctx context.Context, field graphql.CollectedField, obj *NameRecordEntry) graphql.Marshaler {
+func (ec *executionContext) _AuctionBid_bidAmount(ctx context.Context, field graphql.CollectedField, obj *AuctionBid) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "NameRecordEntry",
+		Object: "AuctionBid",
 		Field:  field,
 		Args:   nil,
 	}
@@ -1931,7 +3082,1716 @@ func (ec *executionContext) _NameRecordEntry_height(ctx context.Context, field g
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Height, nil
+		return obj.BidAmount, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*Coin)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOCoin2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐCoin(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorityChange_height(ctx context.Context, field graphql.CollectedField, obj *AuthorityChange) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "AuthorityChange",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Height, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorityChange_name(ctx context.Context, field graphql.CollectedField, obj *AuthorityChange) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "AuthorityChange",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorityChange_authority(ctx context.Context, field graphql.CollectedField, obj *AuthorityChange) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "AuthorityChange",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Authority, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*AuthorityRecord)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOAuthorityRecord2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAuthorityRecord(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorityDelegate_address(ctx context.Context, field graphql.CollectedField, obj *AuthorityDelegate) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "AuthorityDelegate",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Address, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorityDelegate_permissions(ctx context.Context, field graphql.CollectedField, obj *AuthorityDelegate) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "AuthorityDelegate",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Permissions, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2ᚕstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorityRecord_ownerAddress(ctx context.Context, field graphql.CollectedField, obj *AuthorityRecord) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "AuthorityRecord",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OwnerAddress, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorityRecord_ownerPublicKey(ctx context.Context, field graphql.CollectedField, obj *AuthorityRecord) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "AuthorityRecord",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OwnerPublicKey, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorityRecord_height(ctx context.Context, field graphql.CollectedField, obj *AuthorityRecord) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "AuthorityRecord",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Height, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorityRecord_pendingOwnerAddress(ctx context.Context, field graphql.CollectedField, obj *AuthorityRecord) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "AuthorityRecord",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PendingOwnerAddress, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorityRecord_bondId(ctx context.Context, field graphql.CollectedField, obj *AuthorityRecord) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "AuthorityRecord",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.BondID, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorityRecord_expiryTime(ctx context.Context, field graphql.CollectedField, obj *AuthorityRecord) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "AuthorityRecord",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ExpiryTime, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorityRecord_status(ctx context.Context, field graphql.CollectedField, obj *AuthorityRecord) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "AuthorityRecord",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorityRecord_auctionId(ctx context.Context, field graphql.CollectedField, obj *AuthorityRecord) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "AuthorityRecord",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AuctionID, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorityRecord_owners(ctx context.Context, field graphql.CollectedField, obj *AuthorityRecord) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "AuthorityRecord",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Owners, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚕstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorityRecord_threshold(ctx context.Context, field graphql.CollectedField, obj *AuthorityRecord) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "AuthorityRecord",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Threshold, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorityRecord_delegates(ctx context.Context, field graphql.CollectedField, obj *AuthorityRecord) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "AuthorityRecord",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Delegates, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]AuthorityDelegate)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOAuthorityDelegate2ᚕgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAuthorityDelegate(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorityResult_meta(ctx context.Context, field graphql.CollectedField, obj *AuthorityResult) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "AuthorityResult",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Meta, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(ResultMeta)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNResultMeta2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐResultMeta(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthorityResult_records(ctx context.Context, field graphql.CollectedField, obj *AuthorityResult) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "AuthorityResult",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Records, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*AuthorityRecord)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNAuthorityRecord2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAuthorityRecord(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Bond_id(ctx context.Context, field graphql.CollectedField, obj *Bond) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Bond",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Bond_owner(ctx context.Context, field graphql.CollectedField, obj *Bond) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Bond",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Owner, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Bond_balance(ctx context.Context, field graphql.CollectedField, obj *Bond) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Bond",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Balance, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]Coin)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOCoin2ᚕgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐCoin(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _BondResult_meta(ctx context.Context, field graphql.CollectedField, obj *BondResult) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "BondResult",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Meta, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(ResultMeta)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNResultMeta2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐResultMeta(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _BondResult_bonds(ctx context.Context, field graphql.CollectedField, obj *BondResult) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "BondResult",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Bonds, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*Bond)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBond2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐBond(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Coin_type(ctx context.Context, field graphql.CollectedField, obj *Coin) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Coin",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Coin_quantity(ctx context.Context, field graphql.CollectedField, obj *Coin) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Coin",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Quantity, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _KeyValue_key(ctx context.Context, field graphql.CollectedField, obj *KeyValue) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "KeyValue",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Key, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _KeyValue_value(ctx context.Context, field graphql.CollectedField, obj *KeyValue) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "KeyValue",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Value, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Value)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNValue2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐValue(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_insertRecord(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_insertRecord_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().InsertRecord(rctx, args["attributes"].([]*KeyValueInput))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*Record)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalORecord2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecord(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_submit(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Mutation",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_submit_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().Submit(rctx, args["tx"].(string), args["mode"].(*BroadcastMode), args["waitForSync"].(*bool))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _NameChange_height(ctx context.Context, field graphql.CollectedField, obj *NameChange) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "NameChange",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Height, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _NameChange_wrn(ctx context.Context, field graphql.CollectedField, obj *NameChange) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "NameChange",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Wrn, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _NameChange_record(ctx context.Context, field graphql.CollectedField, obj *NameChange) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "NameChange",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Record, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*NameRecord)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalONameRecord2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐNameRecord(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _NameRecord_latest(ctx context.Context, field graphql.CollectedField, obj *NameRecord) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "NameRecord",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Latest, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(NameRecordEntry)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNNameRecordEntry2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐNameRecordEntry(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _NameRecord_history(ctx context.Context, field graphql.CollectedField, obj *NameRecord) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "NameRecord",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.History, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*NameRecordEntry)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalONameRecordEntry2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐNameRecordEntry(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _NameRecord_proof(ctx context.Context, field graphql.CollectedField, obj *NameRecord) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "NameRecord",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.NameRecord().Proof(rctx, obj)
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*Proof)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOProof2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐProof(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _NameRecordEntry_id(ctx context.Context, field graphql.CollectedField, obj *NameRecordEntry) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "NameRecordEntry",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _NameRecordEntry_height(ctx context.Context, field graphql.CollectedField, obj *NameRecordEntry) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "NameRecordEntry",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Height, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _NameResult_meta(ctx context.Context, field graphql.CollectedField, obj *NameResult) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "NameResult",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Meta, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(ResultMeta)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNResultMeta2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐResultMeta(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _NameResult_records(ctx context.Context, field graphql.CollectedField, obj *NameResult) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "NameResult",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Records, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*NameRecord)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNNameRecord2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐNameRecord(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _NodeInfo_id(ctx context.Context, field graphql.CollectedField, obj *NodeInfo) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "NodeInfo",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _NodeInfo_network(ctx context.Context, field graphql.CollectedField, obj *NodeInfo) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "NodeInfo",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Network, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _NodeInfo_moniker(ctx context.Context, field graphql.CollectedField, obj *NodeInfo) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "NodeInfo",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Moniker, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PeerInfo_node(ctx context.Context, field graphql.CollectedField, obj *PeerInfo) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "PeerInfo",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Node, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(NodeInfo)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNNodeInfo2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐNodeInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PeerInfo_is_outbound(ctx context.Context, field graphql.CollectedField, obj *PeerInfo) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "PeerInfo",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsOutbound, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PeerInfo_remote_ip(ctx context.Context, field graphql.CollectedField, obj *PeerInfo) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "PeerInfo",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RemoteIP, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Proof_height(ctx context.Context, field graphql.CollectedField, obj *Proof) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Proof",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Height, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Proof_appHash(ctx context.Context, field graphql.CollectedField, obj *Proof) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Proof",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AppHash, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Proof_key(ctx context.Context, field graphql.CollectedField, obj *Proof) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Proof",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Key, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Proof_value(ctx context.Context, field graphql.CollectedField, obj *Proof) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Proof",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Value, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Proof_ops(ctx context.Context, field graphql.CollectedField, obj *Proof) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Proof",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Ops, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]ProofOp)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNProofOp2ᚕgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐProofOp(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProofOp_type(ctx context.Context, field graphql.CollectedField, obj *ProofOp) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "ProofOp",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProofOp_key(ctx context.Context, field graphql.CollectedField, obj *ProofOp) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "ProofOp",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Key, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProofOp_data(ctx context.Context, field graphql.CollectedField, obj *ProofOp) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "ProofOp",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Data, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_getStatus(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetStatus(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*Status)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNStatus2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_getLogs(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_getLogs_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetLogs(rctx, args["count"].(*int))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚕstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_getAccounts(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_getAccounts_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetAccounts(rctx, args["addresses"].([]string))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*Account)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOAccount2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAccount(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_getBondsByIds(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_getBondsByIds_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetBondsByIds(rctx, args["ids"].([]string))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*Bond)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOBond2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐBond(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_queryBonds(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_queryBonds_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().QueryBonds(rctx, args["attributes"].([]*KeyValueInput))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*Bond)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOBond2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐBond(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_queryBondsPage(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_queryBondsPage_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().QueryBondsPage(rctx, args["attributes"].([]*KeyValueInput), args["limit"].(*int), args["cursor"].(*string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*BondResult)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBondResult2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐBondResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_getAuctionsByIds(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_getAuctionsByIds_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetAuctionsByIds(rctx, args["ids"].([]string))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*Auction)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOAuction2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAuction(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_getRecordsByIds(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_getRecordsByIds_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetRecordsByIds(rctx, args["ids"].([]string), args["height"].(*string))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*Record)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalORecord2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecord(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_getRecordVersions(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_getRecordVersions_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetRecordVersions(rctx, args["id"].(string))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*Record)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalORecord2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecord(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_queryRecords(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_queryRecords_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().QueryRecords(rctx, args["attributes"].([]*KeyValueInput), args["filter"].(*RecordFilterInput), args["all"].(*bool), args["height"].(*string))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*Record)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalORecord2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecord(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_queryRecordsPage(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_queryRecordsPage_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().QueryRecordsPage(rctx, args["attributes"].([]*KeyValueInput), args["filter"].(*RecordFilterInput), args["all"].(*bool), args["limit"].(*int), args["cursor"].(*string), args["height"].(*string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*RecordResult)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNRecordResult2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecordResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_lookupAuthorities(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_lookupAuthorities_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().LookupAuthorities(rctx, args["names"].([]string), args["height"].(*string))
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -1939,25 +4799,32 @@ func (ec *executionContext) _NameRecordEntry_height(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*AuthorityResult)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNAuthorityResult2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAuthorityResult(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _NameResult_meta(ctx context.Context, field graphql.CollectedField, obj *NameResult) graphql.Marshaler {
+func (ec *executionContext) _Query_lookupNames(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "NameResult",
+		Object: "Query",
 		Field:  field,
 		Args:   nil,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_lookupNames_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Meta, nil
+		return ec.resolvers.Query().LookupNames(rctx, args["names"].([]string), args["height"].(*string))
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -1965,25 +4832,32 @@ func (ec *executionContext) _NameResult_meta(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(ResultMeta)
+	res := resTmp.(*NameResult)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNResultMeta2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐResultMeta(ctx, field.Selections, res)
+	return ec.marshalNNameResult2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐNameResult(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _NameResult_records(ctx context.Context, field graphql.CollectedField, obj *NameResult) graphql.Marshaler {
+func (ec *executionContext) _Query_resolveNames(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "NameResult",
+		Object: "Query",
 		Field:  field,
 		Args:   nil,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_resolveNames_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Records, nil
+		return ec.resolvers.Query().ResolveNames(rctx, args["names"].([]string), args["height"].(*string))
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -1991,17 +4865,70 @@ func (ec *executionContext) _NameResult_records(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*NameRecord)
+	res := resTmp.(*RecordResult)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNNameRecord2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐNameRecord(ctx, field.Selections, res)
+	return ec.marshalNRecordResult2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecordResult(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _NodeInfo_id(ctx context.Context, field graphql.CollectedField, obj *NodeInfo) graphql.Marshaler {
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "NodeInfo",
+		Object: "Query",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query___type_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectType(args["name"].(string))
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectSchema()
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Schema)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RPCNodeInfo_address(ctx context.Context, field graphql.CollectedField, obj *RPCNodeInfo) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "RPCNodeInfo",
 		Field:  field,
 		Args:   nil,
 	}
@@ -2009,7 +4936,7 @@ func (ec *executionContext) _NodeInfo_id(ctx context.Context, field graphql.Coll
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Address, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2023,11 +4950,11 @@ func (ec *executionContext) _NodeInfo_id(ctx context.Context, field graphql.Coll
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _NodeInfo_network(ctx context.Context, field graphql.CollectedField, obj *NodeInfo) graphql.Marshaler {
+func (ec *executionContext) _RPCNodeInfo_calls(ctx context.Context, field graphql.CollectedField, obj *RPCNodeInfo) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "NodeInfo",
+		Object: "RPCNodeInfo",
 		Field:  field,
 		Args:   nil,
 	}
@@ -2035,7 +4962,7 @@ func (ec *executionContext) _NodeInfo_network(ctx context.Context, field graphql
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Network, nil
+		return obj.Calls, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2049,11 +4976,11 @@ func (ec *executionContext) _NodeInfo_network(ctx context.Context, field graphql
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _NodeInfo_moniker(ctx context.Context, field graphql.CollectedField, obj *NodeInfo) graphql.Marshaler {
+func (ec *executionContext) _RPCNodeInfo_errors(ctx context.Context, field graphql.CollectedField, obj *RPCNodeInfo) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "NodeInfo",
+		Object: "RPCNodeInfo",
 		Field:  field,
 		Args:   nil,
 	}
@@ -2061,7 +4988,7 @@ func (ec *executionContext) _NodeInfo_moniker(ctx context.Context, field graphql
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Moniker, nil
+		return obj.Errors, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2075,11 +5002,11 @@ func (ec *executionContext) _NodeInfo_moniker(ctx context.Context, field graphql
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _PeerInfo_node(ctx context.Context, field graphql.CollectedField, obj *PeerInfo) graphql.Marshaler {
+func (ec *executionContext) _RPCNodeInfo_verification_errors(ctx context.Context, field graphql.CollectedField, obj *RPCNodeInfo) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "PeerInfo",
+		Object: "RPCNodeInfo",
 		Field:  field,
 		Args:   nil,
 	}
@@ -2087,7 +5014,7 @@ func (ec *executionContext) _PeerInfo_node(ctx context.Context, field graphql.Co
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Node, nil
+		return obj.VerificationErrors, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2095,17 +5022,17 @@ func (ec *executionContext) _PeerInfo_node(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(NodeInfo)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNNodeInfo2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐNodeInfo(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _PeerInfo_is_outbound(ctx context.Context, field graphql.CollectedField, obj *PeerInfo) graphql.Marshaler {
+func (ec *executionContext) _RPCNodeInfo_error_rate(ctx context.Context, field graphql.CollectedField, obj *RPCNodeInfo) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "PeerInfo",
+		Object: "RPCNodeInfo",
 		Field:  field,
 		Args:   nil,
 	}
@@ -2113,7 +5040,7 @@ func (ec *executionContext) _PeerInfo_is_outbound(ctx context.Context, field gra
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsOutbound, nil
+		return obj.ErrorRate, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2121,17 +5048,17 @@ func (ec *executionContext) _PeerInfo_is_outbound(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(float64)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _PeerInfo_remote_ip(ctx context.Context, field graphql.CollectedField, obj *PeerInfo) graphql.Marshaler {
+func (ec *executionContext) _RPCNodeInfo_latency(ctx context.Context, field graphql.CollectedField, obj *RPCNodeInfo) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "PeerInfo",
+		Object: "RPCNodeInfo",
 		Field:  field,
 		Args:   nil,
 	}
@@ -2139,7 +5066,7 @@ func (ec *executionContext) _PeerInfo_remote_ip(ctx context.Context, field graph
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RemoteIP, nil
+		return obj.Latency, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2153,19 +5080,19 @@ func (ec *executionContext) _PeerInfo_remote_ip(ctx context.Context, field graph
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_getStatus(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _RPCNodeInfo_score(ctx context.Context, field graphql.CollectedField, obj *RPCNodeInfo) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "Query",
+		Object: "RPCNodeInfo",
 		Field:  field,
 		Args:   nil,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetStatus(rctx)
+		return obj.Score, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2173,349 +5100,357 @@ func (ec *executionContext) _Query_getStatus(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*Status)
+	res := resTmp.(float64)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNStatus2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐStatus(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_getLogs(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _RPCNodeInfo_quarantined_until(ctx context.Context, field graphql.CollectedField, obj *RPCNodeInfo) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "Query",
+		Object: "RPCNodeInfo",
 		Field:  field,
 		Args:   nil,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_getLogs_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetLogs(rctx, args["count"].(*int))
+		return obj.QuarantinedUntil, nil
 	})
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOString2ᚕstring(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_getAccounts(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _RPCNodeInfo_last_called_at(ctx context.Context, field graphql.CollectedField, obj *RPCNodeInfo) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "Query",
+		Object: "RPCNodeInfo",
 		Field:  field,
 		Args:   nil,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_getAccounts_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastCalledAt, nil
+	})
+	if resTmp == nil {
 		return graphql.Null
 	}
-	rctx.Args = args
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Record_id(ctx context.Context, field graphql.CollectedField, obj *Record) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Record",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetAccounts(rctx, args["addresses"].([]string))
+		return obj.ID, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*Account)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOAccount2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAccount(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_getBondsByIds(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _Record_names(ctx context.Context, field graphql.CollectedField, obj *Record) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "Query",
+		Object: "Record",
 		Field:  field,
 		Args:   nil,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_getBondsByIds_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Names, nil
+	})
+	if resTmp == nil {
 		return graphql.Null
 	}
-	rctx.Args = args
+	res := resTmp.([]string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚕstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Record_bondId(ctx context.Context, field graphql.CollectedField, obj *Record) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Record",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetBondsByIds(rctx, args["ids"].([]string))
+		return obj.BondID, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*Bond)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOBond2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐBond(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_queryBonds(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _Record_createTime(ctx context.Context, field graphql.CollectedField, obj *Record) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "Query",
+		Object: "Record",
 		Field:  field,
 		Args:   nil,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_queryBonds_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreateTime, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	rctx.Args = args
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Record_expiryTime(ctx context.Context, field graphql.CollectedField, obj *Record) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Record",
+		Field:  field,
+		Args:   nil,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().QueryBonds(rctx, args["attributes"].([]*KeyValueInput))
+		return obj.ExpiryTime, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*Bond)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOBond2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐBond(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_getRecordsByIds(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _Record_owners(ctx context.Context, field graphql.CollectedField, obj *Record) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "Query",
+		Object: "Record",
 		Field:  field,
 		Args:   nil,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_getRecordsByIds_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetRecordsByIds(rctx, args["ids"].([]string))
+		return obj.Owners, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*Record)
+	res := resTmp.([]*string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalORecord2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecord(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_queryRecords(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _Record_attributes(ctx context.Context, field graphql.CollectedField, obj *Record) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "Query",
+		Object: "Record",
 		Field:  field,
 		Args:   nil,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_queryRecords_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().QueryRecords(rctx, args["attributes"].([]*KeyValueInput), args["all"].(*bool))
+		return obj.Attributes, nil
 	})
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*Record)
+	res := resTmp.([]*KeyValue)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalORecord2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecord(ctx, field.Selections, res)
+	return ec.marshalOKeyValue2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐKeyValue(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_lookupAuthorities(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _Record_references(ctx context.Context, field graphql.CollectedField, obj *Record) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "Query",
+		Object: "Record",
 		Field:  field,
 		Args:   nil,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_lookupAuthorities_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().LookupAuthorities(rctx, args["names"].([]string))
+		return obj.References, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*AuthorityResult)
+	res := resTmp.([]*Record)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNAuthorityResult2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAuthorityResult(ctx, field.Selections, res)
+	return ec.marshalORecord2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecord(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_lookupNames(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _Record_predecessorId(ctx context.Context, field graphql.CollectedField, obj *Record) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "Query",
+		Object: "Record",
 		Field:  field,
 		Args:   nil,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_lookupNames_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().LookupNames(rctx, args["names"].([]string))
+		return obj.PredecessorID, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*NameResult)
+	res := resTmp.(*string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNNameResult2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐNameResult(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_resolveNames(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _Record_successorId(ctx context.Context, field graphql.CollectedField, obj *Record) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "Query",
+		Object: "Record",
 		Field:  field,
 		Args:   nil,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_resolveNames_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().ResolveNames(rctx, args["names"].([]string))
+		return obj.SuccessorID, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*RecordResult)
+	res := resTmp.(*string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNRecordResult2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecordResult(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _Record_proof(ctx context.Context, field graphql.CollectedField, obj *Record) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "Query",
+		Object: "Record",
 		Field:  field,
 		Args:   nil,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query___type_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(args["name"].(string))
+		return ec.resolvers.Record().Proof(rctx, obj)
 	})
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*Proof)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalOProof2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐProof(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _RecordChange_height(ctx context.Context, field graphql.CollectedField, obj *RecordChange) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "Query",
+		Object: "RecordChange",
 		Field:  field,
 		Args:   nil,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
+		return obj.Height, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Schema)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Record_id(ctx context.Context, field graphql.CollectedField, obj *Record) graphql.Marshaler {
+func (ec *executionContext) _RecordChange_id(ctx context.Context, field graphql.CollectedField, obj *RecordChange) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "Record",
+		Object: "RecordChange",
 		Field:  field,
 		Args:   nil,
 	}
@@ -2537,11 +5472,11 @@ func (ec *executionContext) _Record_id(ctx context.Context, field graphql.Collec
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Record_names(ctx context.Context, field graphql.CollectedField, obj *Record) graphql.Marshaler {
+func (ec *executionContext) _RecordChange_record(ctx context.Context, field graphql.CollectedField, obj *RecordChange) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "Record",
+		Object: "RecordChange",
 		Field:  field,
 		Args:   nil,
 	}
@@ -2549,22 +5484,22 @@ func (ec *executionContext) _Record_names(ctx context.Context, field graphql.Col
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Names, nil
+		return obj.Record, nil
 	})
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*Record)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOString2ᚕstring(ctx, field.Selections, res)
+	return ec.marshalORecord2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecord(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Record_bondId(ctx context.Context, field graphql.CollectedField, obj *Record) graphql.Marshaler {
+func (ec *executionContext) _RecordResult_meta(ctx context.Context, field graphql.CollectedField, obj *RecordResult) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "Record",
+		Object: "RecordResult",
 		Field:  field,
 		Args:   nil,
 	}
@@ -2572,7 +5507,7 @@ func (ec *executionContext) _Record_bondId(ctx context.Context, field graphql.Co
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BondID, nil
+		return obj.Meta, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2580,17 +5515,17 @@ func (ec *executionContext) _Record_bondId(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(ResultMeta)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNResultMeta2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐResultMeta(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Record_createTime(ctx context.Context, field graphql.CollectedField, obj *Record) graphql.Marshaler {
+func (ec *executionContext) _RecordResult_records(ctx context.Context, field graphql.CollectedField, obj *RecordResult) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "Record",
+		Object: "RecordResult",
 		Field:  field,
 		Args:   nil,
 	}
@@ -2598,7 +5533,7 @@ func (ec *executionContext) _Record_createTime(ctx context.Context, field graphq
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreateTime, nil
+		return obj.Records, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2606,17 +5541,17 @@ func (ec *executionContext) _Record_createTime(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*Record)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNRecord2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecord(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Record_expiryTime(ctx context.Context, field graphql.CollectedField, obj *Record) graphql.Marshaler {
+func (ec *executionContext) _Reference_id(ctx context.Context, field graphql.CollectedField, obj *Reference) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "Record",
+		Object: "Reference",
 		Field:  field,
 		Args:   nil,
 	}
@@ -2624,7 +5559,7 @@ func (ec *executionContext) _Record_expiryTime(ctx context.Context, field graphq
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExpiryTime, nil
+		return obj.ID, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2638,11 +5573,11 @@ func (ec *executionContext) _Record_expiryTime(ctx context.Context, field graphq
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Record_owners(ctx context.Context, field graphql.CollectedField, obj *Record) graphql.Marshaler {
+func (ec *executionContext) _ResultMeta_height(ctx context.Context, field graphql.CollectedField, obj *ResultMeta) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "Record",
+		Object: "ResultMeta",
 		Field:  field,
 		Args:   nil,
 	}
@@ -2650,7 +5585,7 @@ func (ec *executionContext) _Record_owners(ctx context.Context, field graphql.Co
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Owners, nil
+		return obj.Height, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2658,17 +5593,17 @@ func (ec *executionContext) _Record_owners(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*string)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2ᚕᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Record_attributes(ctx context.Context, field graphql.CollectedField, obj *Record) graphql.Marshaler {
+func (ec *executionContext) _ResultMeta_nextCursor(ctx context.Context, field graphql.CollectedField, obj *ResultMeta) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "Record",
+		Object: "ResultMeta",
 		Field:  field,
 		Args:   nil,
 	}
@@ -2676,22 +5611,22 @@ func (ec *executionContext) _Record_attributes(ctx context.Context, field graphq
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Attributes, nil
+		return obj.NextCursor, nil
 	})
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*KeyValue)
+	res := resTmp.(*string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOKeyValue2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐKeyValue(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Record_references(ctx context.Context, field graphql.CollectedField, obj *Record) graphql.Marshaler {
+func (ec *executionContext) _ResultMeta_proof(ctx context.Context, field graphql.CollectedField, obj *ResultMeta) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "Record",
+		Object: "ResultMeta",
 		Field:  field,
 		Args:   nil,
 	}
@@ -2699,22 +5634,22 @@ func (ec *executionContext) _Record_references(ctx context.Context, field graphq
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.References, nil
+		return ec.resolvers.ResultMeta().Proof(rctx, obj)
 	})
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*Record)
+	res := resTmp.(*StateProof)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalORecord2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecord(ctx, field.Selections, res)
+	return ec.marshalOStateProof2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐStateProof(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RecordResult_meta(ctx context.Context, field graphql.CollectedField, obj *RecordResult) graphql.Marshaler {
+func (ec *executionContext) _StateProof_height(ctx context.Context, field graphql.CollectedField, obj *StateProof) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "RecordResult",
+		Object: "StateProof",
 		Field:  field,
 		Args:   nil,
 	}
@@ -2722,7 +5657,7 @@ func (ec *executionContext) _RecordResult_meta(ctx context.Context, field graphq
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Meta, nil
+		return obj.Height, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2730,17 +5665,17 @@ func (ec *executionContext) _RecordResult_meta(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(ResultMeta)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNResultMeta2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐResultMeta(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RecordResult_records(ctx context.Context, field graphql.CollectedField, obj *RecordResult) graphql.Marshaler {
+func (ec *executionContext) _StateProof_appHash(ctx context.Context, field graphql.CollectedField, obj *StateProof) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "RecordResult",
+		Object: "StateProof",
 		Field:  field,
 		Args:   nil,
 	}
@@ -2748,7 +5683,7 @@ func (ec *executionContext) _RecordResult_records(ctx context.Context, field gra
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Records, nil
+		return obj.AppHash, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2756,17 +5691,17 @@ func (ec *executionContext) _RecordResult_records(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*Record)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNRecord2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecord(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Reference_id(ctx context.Context, field graphql.CollectedField, obj *Reference) graphql.Marshaler {
+func (ec *executionContext) _Status_version(ctx context.Context, field graphql.CollectedField, obj *Status) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "Reference",
+		Object: "Status",
 		Field:  field,
 		Args:   nil,
 	}
@@ -2774,7 +5709,7 @@ func (ec *executionContext) _Reference_id(ctx context.Context, field graphql.Col
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Version, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2788,11 +5723,11 @@ func (ec *executionContext) _Reference_id(ctx context.Context, field graphql.Col
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ResultMeta_height(ctx context.Context, field graphql.CollectedField, obj *ResultMeta) graphql.Marshaler {
+func (ec *executionContext) _Status_node(ctx context.Context, field graphql.CollectedField, obj *Status) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object: "ResultMeta",
+		Object: "Status",
 		Field:  field,
 		Args:   nil,
 	}
@@ -2800,7 +5735,7 @@ func (ec *executionContext) _ResultMeta_height(ctx context.Context, field graphq
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Height, nil
+		return obj.Node, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2808,13 +5743,13 @@ func (ec *executionContext) _ResultMeta_height(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(NodeInfo)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNNodeInfo2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐNodeInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Status_version(ctx context.Context, field graphql.CollectedField, obj *Status) graphql.Marshaler {
+func (ec *executionContext) _Status_sync(ctx context.Context, field graphql.CollectedField, obj *Status) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
@@ -2826,7 +5761,7 @@ func (ec *executionContext) _Status_version(ctx context.Context, field graphql.C
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Version, nil
+		return obj.Sync, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2834,13 +5769,13 @@ func (ec *executionContext) _Status_version(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(SyncInfo)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNSyncInfo2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐSyncInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Status_node(ctx context.Context, field graphql.CollectedField, obj *Status) graphql.Marshaler {
+func (ec *executionContext) _Status_validator(ctx context.Context, field graphql.CollectedField, obj *Status) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
@@ -2852,21 +5787,18 @@ func (ec *executionContext) _Status_node(ctx context.Context, field graphql.Coll
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Node, nil
+		return obj.Validator, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(NodeInfo)
+	res := resTmp.(*ValidatorInfo)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNNodeInfo2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐNodeInfo(ctx, field.Selections, res)
+	return ec.marshalOValidatorInfo2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐValidatorInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Status_sync(ctx context.Context, field graphql.CollectedField, obj *Status) graphql.Marshaler {
+func (ec *executionContext) _Status_validators(ctx context.Context, field graphql.CollectedField, obj *Status) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
@@ -2878,7 +5810,7 @@ func (ec *executionContext) _Status_sync(ctx context.Context, field graphql.Coll
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Sync, nil
+		return obj.Validators, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2886,13 +5818,13 @@ func (ec *executionContext) _Status_sync(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(SyncInfo)
+	res := resTmp.([]*ValidatorInfo)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNSyncInfo2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐSyncInfo(ctx, field.Selections, res)
+	return ec.marshalNValidatorInfo2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐValidatorInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Status_validator(ctx context.Context, field graphql.CollectedField, obj *Status) graphql.Marshaler {
+func (ec *executionContext) _Status_num_peers(ctx context.Context, field graphql.CollectedField, obj *Status) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
@@ -2904,18 +5836,21 @@ func (ec *executionContext) _Status_validator(ctx context.Context, field graphql
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Validator, nil
+		return obj.NumPeers, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*ValidatorInfo)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOValidatorInfo2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐValidatorInfo(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Status_validators(ctx context.Context, field graphql.CollectedField, obj *Status) graphql.Marshaler {
+func (ec *executionContext) _Status_peers(ctx context.Context, field graphql.CollectedField, obj *Status) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
@@ -2927,21 +5862,18 @@ func (ec *executionContext) _Status_validators(ctx context.Context, field graphq
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Validators, nil
+		return obj.Peers, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*ValidatorInfo)
+	res := resTmp.([]*PeerInfo)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNValidatorInfo2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐValidatorInfo(ctx, field.Selections, res)
+	return ec.marshalOPeerInfo2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐPeerInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Status_num_peers(ctx context.Context, field graphql.CollectedField, obj *Status) graphql.Marshaler {
+func (ec *executionContext) _Status_disk_usage(ctx context.Context, field graphql.CollectedField, obj *Status) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
@@ -2953,7 +5885,7 @@ func (ec *executionContext) _Status_num_peers(ctx context.Context, field graphql
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.NumPeers, nil
+		return obj.DiskUsage, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2967,7 +5899,7 @@ func (ec *executionContext) _Status_num_peers(ctx context.Context, field graphql
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Status_peers(ctx context.Context, field graphql.CollectedField, obj *Status) graphql.Marshaler {
+func (ec *executionContext) _Status_rpc_nodes(ctx context.Context, field graphql.CollectedField, obj *Status) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
@@ -2979,18 +5911,18 @@ func (ec *executionContext) _Status_peers(ctx context.Context, field graphql.Col
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Peers, nil
+		return obj.RPCNodes, nil
 	})
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*PeerInfo)
+	res := resTmp.([]*RPCNodeInfo)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOPeerInfo2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐPeerInfo(ctx, field.Selections, res)
+	return ec.marshalORPCNodeInfo2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRPCNodeInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Status_disk_usage(ctx context.Context, field graphql.CollectedField, obj *Status) graphql.Marshaler {
+func (ec *executionContext) _Status_lite_peers(ctx context.Context, field graphql.CollectedField, obj *Status) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
@@ -3002,18 +5934,117 @@ func (ec *executionContext) _Status_disk_usage(ctx context.Context, field graphq
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DiskUsage, nil
+		return obj.LitePeers, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*RPCNodeInfo)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalORPCNodeInfo2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRPCNodeInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Subscription_onNameChanged(ctx context.Context, field graphql.CollectedField) func() graphql.Marshaler {
+	ctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{
+		Field: field,
+		Args:  nil,
+	})
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Subscription_onNameChanged_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	// FIXME: subscriptions are missing request middleware stack https://github.com/99designs/gqlgen/issues/259
+	//          and Tracer stack
+	rctx := ctx
+	results, err := ec.resolvers.Subscription().OnNameChanged(rctx, args["prefix"].(*string))
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	return func() graphql.Marshaler {
+		res, ok := <-results
+		if !ok {
+			return nil
+		}
+		return graphql.WriterFunc(func(w io.Writer) {
+			w.Write([]byte{'{'})
+			graphql.MarshalString(field.Alias).MarshalGQL(w)
+			w.Write([]byte{':'})
+			ec.marshalNNameChange2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐNameChange(ctx, field.Selections, res).MarshalGQL(w)
+			w.Write([]byte{'}'})
+		})
+	}
+}
+
+func (ec *executionContext) _Subscription_onRecordChanged(ctx context.Context, field graphql.CollectedField) func() graphql.Marshaler {
+	ctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{
+		Field: field,
+		Args:  nil,
+	})
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Subscription_onRecordChanged_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	// FIXME: subscriptions are missing request middleware stack https://github.com/99designs/gqlgen/issues/259
+	//          and Tracer stack
+	rctx := ctx
+	results, err := ec.resolvers.Subscription().OnRecordChanged(rctx, args["attributes"].([]*KeyValueInput))
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	return func() graphql.Marshaler {
+		res, ok := <-results
+		if !ok {
+			return nil
+		}
+		return graphql.WriterFunc(func(w io.Writer) {
+			w.Write([]byte{'{'})
+			graphql.MarshalString(field.Alias).MarshalGQL(w)
+			w.Write([]byte{':'})
+			ec.marshalNRecordChange2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecordChange(ctx, field.Selections, res).MarshalGQL(w)
+			w.Write([]byte{'}'})
+		})
+	}
+}
+
+func (ec *executionContext) _Subscription_onAuthorityChanged(ctx context.Context, field graphql.CollectedField) func() graphql.Marshaler {
+	ctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{
+		Field: field,
+		Args:  nil,
+	})
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Subscription_onAuthorityChanged_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	// FIXME: subscriptions are missing request middleware stack https://github.com/99designs/gqlgen/issues/259
+	//          and Tracer stack
+	rctx := ctx
+	results, err := ec.resolvers.Subscription().OnAuthorityChanged(rctx, args["prefix"].(*string))
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	return func() graphql.Marshaler {
+		res, ok := <-results
+		if !ok {
+			return nil
+		}
+		return graphql.WriterFunc(func(w io.Writer) {
+			w.Write([]byte{'{'})
+			graphql.MarshalString(field.Alias).MarshalGQL(w)
+			w.Write([]byte{':'})
+			ec.marshalNAuthorityChange2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAuthorityChange(ctx, field.Selections, res).MarshalGQL(w)
+			w.Write([]byte{'}'})
+		})
+	}
 }
 
 func (ec *executionContext) _SyncInfo_latest_block_hash(ctx context.Context, field graphql.CollectedField, obj *SyncInfo) graphql.Marshaler {
@@ -4178,6 +7209,42 @@ func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.Co
 
 // region    **************************** input.gotpl *****************************
 
+func (ec *executionContext) unmarshalInputAttributeFilterInput(ctx context.Context, v interface{}) (AttributeFilterInput, error) {
+	var it AttributeFilterInput
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "key":
+			var err error
+			it.Key, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "op":
+			var err error
+			it.Op, err = ec.unmarshalOFilterOperator2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐFilterOperator(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "value":
+			var err error
+			it.Value, err = ec.unmarshalNValueInput2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐValueInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "not":
+			var err error
+			it.Not, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputKeyValueInput(ctx context.Context, v interface{}) (KeyValueInput, error) {
 	var it KeyValueInput
 	var asMap = v.(map[string]interface{})
@@ -4202,6 +7269,30 @@ func (ec *executionContext) unmarshalInputKeyValueInput(ctx context.Context, v i
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputRecordFilterInput(ctx context.Context, v interface{}) (RecordFilterInput, error) {
+	var it RecordFilterInput
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "attributes":
+			var err error
+			it.Attributes, err = ec.unmarshalOAttributeFilterInput2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAttributeFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "or":
+			var err error
+			it.Or, err = ec.unmarshalORecordFilterInput2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecordFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputReferenceInput(ctx context.Context, v interface{}) (ReferenceInput, error) {
 	var it ReferenceInput
 	var asMap = v.(map[string]interface{})
@@ -4256,62 +7347,247 @@ func (ec *executionContext) unmarshalInputValueInput(ctx context.Context, v inte
 			if err != nil {
 				return it, err
 			}
-		case "reference":
-			var err error
-			it.Reference, err = ec.unmarshalOReferenceInput2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐReferenceInput(ctx, v)
-			if err != nil {
-				return it, err
+		case "reference":
+			var err error
+			it.Reference, err = ec.unmarshalOReferenceInput2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐReferenceInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "values":
+			var err error
+			it.Values, err = ec.unmarshalOValueInput2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐValueInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var accountImplementors = []string{"Account"}
+
+func (ec *executionContext) _Account(ctx context.Context, sel ast.SelectionSet, obj *Account) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, accountImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	invalid := false
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Account")
+		case "address":
+			out.Values[i] = ec._Account_address(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "pubKey":
+			out.Values[i] = ec._Account_pubKey(ctx, field, obj)
+		case "number":
+			out.Values[i] = ec._Account_number(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "sequence":
+			out.Values[i] = ec._Account_sequence(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "balance":
+			out.Values[i] = ec._Account_balance(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+var auctionImplementors = []string{"Auction"}
+
+func (ec *executionContext) _Auction(ctx context.Context, sel ast.SelectionSet, obj *Auction) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, auctionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	invalid := false
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Auction")
+		case "id":
+			out.Values[i] = ec._Auction_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "status":
+			out.Values[i] = ec._Auction_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "ownerAddress":
+			out.Values[i] = ec._Auction_ownerAddress(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "createTime":
+			out.Values[i] = ec._Auction_createTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "commitsEndTime":
+			out.Values[i] = ec._Auction_commitsEndTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "revealsEndTime":
+			out.Values[i] = ec._Auction_revealsEndTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "minimumBid":
+			out.Values[i] = ec._Auction_minimumBid(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "commitDeposit":
+			out.Values[i] = ec._Auction_commitDeposit(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "winnerAddress":
+			out.Values[i] = ec._Auction_winnerAddress(ctx, field, obj)
+		case "winningBid":
+			out.Values[i] = ec._Auction_winningBid(ctx, field, obj)
+		case "winningPrice":
+			out.Values[i] = ec._Auction_winningPrice(ctx, field, obj)
+		case "bids":
+			out.Values[i] = ec._Auction_bids(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+var auctionBidImplementors = []string{"AuctionBid"}
+
+func (ec *executionContext) _AuctionBid(ctx context.Context, sel ast.SelectionSet, obj *AuctionBid) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, auctionBidImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	invalid := false
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuctionBid")
+		case "bidderAddress":
+			out.Values[i] = ec._AuctionBid_bidderAddress(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "status":
+			out.Values[i] = ec._AuctionBid_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
 			}
-		case "values":
-			var err error
-			it.Values, err = ec.unmarshalOValueInput2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐValueInput(ctx, v)
-			if err != nil {
-				return it, err
+		case "commitHash":
+			out.Values[i] = ec._AuctionBid_commitHash(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "commitTime":
+			out.Values[i] = ec._AuctionBid_commitTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
 			}
+		case "revealTime":
+			out.Values[i] = ec._AuctionBid_revealTime(ctx, field, obj)
+		case "bidAmount":
+			out.Values[i] = ec._AuctionBid_bidAmount(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-
-	return it, nil
+	out.Dispatch()
+	if invalid {
+		return graphql.Null
+	}
+	return out
 }
 
-// endregion **************************** input.gotpl *****************************
-
-// region    ************************** interface.gotpl ***************************
+var authorityChangeImplementors = []string{"AuthorityChange"}
 
-// endregion ************************** interface.gotpl ***************************
+func (ec *executionContext) _AuthorityChange(ctx context.Context, sel ast.SelectionSet, obj *AuthorityChange) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, authorityChangeImplementors)
 
-// region    **************************** object.gotpl ****************************
+	out := graphql.NewFieldSet(fields)
+	invalid := false
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuthorityChange")
+		case "height":
+			out.Values[i] = ec._AuthorityChange_height(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "name":
+			out.Values[i] = ec._AuthorityChange_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "authority":
+			out.Values[i] = ec._AuthorityChange_authority(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
 
-var accountImplementors = []string{"Account"}
+var authorityDelegateImplementors = []string{"AuthorityDelegate"}
 
-func (ec *executionContext) _Account(ctx context.Context, sel ast.SelectionSet, obj *Account) graphql.Marshaler {
-	fields := graphql.CollectFields(ctx, sel, accountImplementors)
+func (ec *executionContext) _AuthorityDelegate(ctx context.Context, sel ast.SelectionSet, obj *AuthorityDelegate) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, authorityDelegateImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	invalid := false
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Account")
+			out.Values[i] = graphql.MarshalString("AuthorityDelegate")
 		case "address":
-			out.Values[i] = ec._Account_address(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalid = true
-			}
-		case "pubKey":
-			out.Values[i] = ec._Account_pubKey(ctx, field, obj)
-		case "number":
-			out.Values[i] = ec._Account_number(ctx, field, obj)
+			out.Values[i] = ec._AuthorityDelegate_address(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
-		case "sequence":
-			out.Values[i] = ec._Account_sequence(ctx, field, obj)
+		case "permissions":
+			out.Values[i] = ec._AuthorityDelegate_permissions(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
-		case "balance":
-			out.Values[i] = ec._Account_balance(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -4349,6 +7625,25 @@ func (ec *executionContext) _AuthorityRecord(ctx context.Context, sel ast.Select
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
+		case "pendingOwnerAddress":
+			out.Values[i] = ec._AuthorityRecord_pendingOwnerAddress(ctx, field, obj)
+		case "bondId":
+			out.Values[i] = ec._AuthorityRecord_bondId(ctx, field, obj)
+		case "expiryTime":
+			out.Values[i] = ec._AuthorityRecord_expiryTime(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._AuthorityRecord_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "auctionId":
+			out.Values[i] = ec._AuthorityRecord_auctionId(ctx, field, obj)
+		case "owners":
+			out.Values[i] = ec._AuthorityRecord_owners(ctx, field, obj)
+		case "threshold":
+			out.Values[i] = ec._AuthorityRecord_threshold(ctx, field, obj)
+		case "delegates":
+			out.Values[i] = ec._AuthorityRecord_delegates(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -4426,6 +7721,38 @@ func (ec *executionContext) _Bond(ctx context.Context, sel ast.SelectionSet, obj
 	return out
 }
 
+var bondResultImplementors = []string{"BondResult"}
+
+func (ec *executionContext) _BondResult(ctx context.Context, sel ast.SelectionSet, obj *BondResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, bondResultImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	invalid := false
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("BondResult")
+		case "meta":
+			out.Values[i] = ec._BondResult_meta(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "bonds":
+			out.Values[i] = ec._BondResult_bonds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
 var coinImplementors = []string{"Coin"}
 
 func (ec *executionContext) _Coin(ctx context.Context, sel ast.SelectionSet, obj *Coin) graphql.Marshaler {
@@ -4520,6 +7847,40 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 	return out
 }
 
+var nameChangeImplementors = []string{"NameChange"}
+
+func (ec *executionContext) _NameChange(ctx context.Context, sel ast.SelectionSet, obj *NameChange) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, nameChangeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	invalid := false
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("NameChange")
+		case "height":
+			out.Values[i] = ec._NameChange_height(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "wrn":
+			out.Values[i] = ec._NameChange_wrn(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "record":
+			out.Values[i] = ec._NameChange_record(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
 var nameRecordImplementors = []string{"NameRecord"}
 
 func (ec *executionContext) _NameRecord(ctx context.Context, sel ast.SelectionSet, obj *NameRecord) graphql.Marshaler {
@@ -4538,6 +7899,17 @@ func (ec *executionContext) _NameRecord(ctx context.Context, sel ast.SelectionSe
 			}
 		case "history":
 			out.Values[i] = ec._NameRecord_history(ctx, field, obj)
+		case "proof":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._NameRecord_proof(ctx, field, obj)
+				return res
+			})
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -4687,6 +8059,87 @@ func (ec *executionContext) _PeerInfo(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
+var proofImplementors = []string{"Proof"}
+
+func (ec *executionContext) _Proof(ctx context.Context, sel ast.SelectionSet, obj *Proof) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, proofImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	invalid := false
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Proof")
+		case "height":
+			out.Values[i] = ec._Proof_height(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "appHash":
+			out.Values[i] = ec._Proof_appHash(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "key":
+			out.Values[i] = ec._Proof_key(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "value":
+			out.Values[i] = ec._Proof_value(ctx, field, obj)
+		case "ops":
+			out.Values[i] = ec._Proof_ops(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+var proofOpImplementors = []string{"ProofOp"}
+
+func (ec *executionContext) _ProofOp(ctx context.Context, sel ast.SelectionSet, obj *ProofOp) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, proofOpImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	invalid := false
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProofOp")
+		case "type":
+			out.Values[i] = ec._ProofOp_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "key":
+			out.Values[i] = ec._ProofOp_key(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "data":
+			out.Values[i] = ec._ProofOp_data(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
 var queryImplementors = []string{"Query"}
 
 func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
@@ -4760,6 +8213,31 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				res = ec._Query_queryBonds(ctx, field)
 				return res
 			})
+		case "queryBondsPage":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_queryBondsPage(ctx, field)
+				if res == graphql.Null {
+					invalid = true
+				}
+				return res
+			})
+		case "getAuctionsByIds":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getAuctionsByIds(ctx, field)
+				return res
+			})
 		case "getRecordsByIds":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
@@ -4771,6 +8249,17 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				res = ec._Query_getRecordsByIds(ctx, field)
 				return res
 			})
+		case "getRecordVersions":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getRecordVersions(ctx, field)
+				return res
+			})
 		case "queryRecords":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
@@ -4782,6 +8271,20 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				res = ec._Query_queryRecords(ctx, field)
 				return res
 			})
+		case "queryRecordsPage":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_queryRecordsPage(ctx, field)
+				if res == graphql.Null {
+					invalid = true
+				}
+				return res
+			})
 		case "lookupAuthorities":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
@@ -4839,6 +8342,67 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 	return out
 }
 
+var rPCNodeInfoImplementors = []string{"RPCNodeInfo"}
+
+func (ec *executionContext) _RPCNodeInfo(ctx context.Context, sel ast.SelectionSet, obj *RPCNodeInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, rPCNodeInfoImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	invalid := false
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RPCNodeInfo")
+		case "address":
+			out.Values[i] = ec._RPCNodeInfo_address(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "calls":
+			out.Values[i] = ec._RPCNodeInfo_calls(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "errors":
+			out.Values[i] = ec._RPCNodeInfo_errors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "verification_errors":
+			out.Values[i] = ec._RPCNodeInfo_verification_errors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "error_rate":
+			out.Values[i] = ec._RPCNodeInfo_error_rate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "latency":
+			out.Values[i] = ec._RPCNodeInfo_latency(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "score":
+			out.Values[i] = ec._RPCNodeInfo_score(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "quarantined_until":
+			out.Values[i] = ec._RPCNodeInfo_quarantined_until(ctx, field, obj)
+		case "last_called_at":
+			out.Values[i] = ec._RPCNodeInfo_last_called_at(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
 var recordImplementors = []string{"Record"}
 
 func (ec *executionContext) _Record(ctx context.Context, sel ast.SelectionSet, obj *Record) graphql.Marshaler {
@@ -4881,6 +8445,55 @@ func (ec *executionContext) _Record(ctx context.Context, sel ast.SelectionSet, o
 			out.Values[i] = ec._Record_attributes(ctx, field, obj)
 		case "references":
 			out.Values[i] = ec._Record_references(ctx, field, obj)
+		case "predecessorId":
+			out.Values[i] = ec._Record_predecessorId(ctx, field, obj)
+		case "successorId":
+			out.Values[i] = ec._Record_successorId(ctx, field, obj)
+		case "proof":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Record_proof(ctx, field, obj)
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+var recordChangeImplementors = []string{"RecordChange"}
+
+func (ec *executionContext) _RecordChange(ctx context.Context, sel ast.SelectionSet, obj *RecordChange) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, recordChangeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	invalid := false
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RecordChange")
+		case "height":
+			out.Values[i] = ec._RecordChange_height(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "id":
+			out.Values[i] = ec._RecordChange_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "record":
+			out.Values[i] = ec._RecordChange_record(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -4967,6 +8580,51 @@ func (ec *executionContext) _ResultMeta(ctx context.Context, sel ast.SelectionSe
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
+		case "nextCursor":
+			out.Values[i] = ec._ResultMeta_nextCursor(ctx, field, obj)
+		case "proof":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ResultMeta_proof(ctx, field, obj)
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+var stateProofImplementors = []string{"StateProof"}
+
+func (ec *executionContext) _StateProof(ctx context.Context, sel ast.SelectionSet, obj *StateProof) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, stateProofImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	invalid := false
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("StateProof")
+		case "height":
+			out.Values[i] = ec._StateProof_height(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		case "appHash":
+			out.Values[i] = ec._StateProof_appHash(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -5023,6 +8681,10 @@ func (ec *executionContext) _Status(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
+		case "rpc_nodes":
+			out.Values[i] = ec._Status_rpc_nodes(ctx, field, obj)
+		case "lite_peers":
+			out.Values[i] = ec._Status_lite_peers(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -5034,6 +8696,30 @@ func (ec *executionContext) _Status(ctx context.Context, sel ast.SelectionSet, o
 	return out
 }
 
+var subscriptionImplementors = []string{"Subscription"}
+
+func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func() graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, subscriptionImplementors)
+	ctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{
+		Object: "Subscription",
+	})
+	if len(fields) != 1 {
+		ec.Errorf(ctx, "must subscribe to exactly one stream")
+		return nil
+	}
+
+	switch fields[0].Name {
+	case "onNameChanged":
+		return ec._Subscription_onNameChanged(ctx, fields[0])
+	case "onRecordChanged":
+		return ec._Subscription_onRecordChanged(ctx, fields[0])
+	case "onAuthorityChanged":
+		return ec._Subscription_onAuthorityChanged(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
+	}
+}
+
 var syncInfoImplementors = []string{"SyncInfo"}
 
 func (ec *executionContext) _SyncInfo(ctx context.Context, sel ast.SelectionSet, obj *SyncInfo) graphql.Marshaler {
@@ -5393,6 +9079,61 @@ func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, o
 
 // region    ***************************** type.gotpl *****************************
 
+func (ec *executionContext) marshalNAuctionBid2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAuctionBid(ctx context.Context, sel ast.SelectionSet, v []*AuctionBid) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOAuctionBid2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAuctionBid(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNAuthorityChange2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAuthorityChange(ctx context.Context, sel ast.SelectionSet, v AuthorityChange) graphql.Marshaler {
+	return ec._AuthorityChange(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNAuthorityChange2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAuthorityChange(ctx context.Context, sel ast.SelectionSet, v *AuthorityChange) graphql.Marshaler {
+	if v == nil {
+		if !ec.HasError(graphql.GetResolverContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._AuthorityChange(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNAuthorityDelegate2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAuthorityDelegate(ctx context.Context, sel ast.SelectionSet, v AuthorityDelegate) graphql.Marshaler {
+	return ec._AuthorityDelegate(ctx, sel, &v)
+}
+
 func (ec *executionContext) marshalNAuthorityRecord2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAuthorityRecord(ctx context.Context, sel ast.SelectionSet, v []*AuthorityRecord) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
@@ -5444,6 +9185,57 @@ func (ec *executionContext) marshalNAuthorityResult2ᚖgithubᚗcomᚋwirelineio
 	return ec._AuthorityResult(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNBond2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐBond(ctx context.Context, sel ast.SelectionSet, v []*Bond) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOBond2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐBond(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNBondResult2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐBondResult(ctx context.Context, sel ast.SelectionSet, v BondResult) graphql.Marshaler {
+	return ec._BondResult(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNBondResult2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐBondResult(ctx context.Context, sel ast.SelectionSet, v *BondResult) graphql.Marshaler {
+	if v == nil {
+		if !ec.HasError(graphql.GetResolverContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._BondResult(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
 	return graphql.UnmarshalBoolean(v)
 }
@@ -5456,6 +9248,14 @@ func (ec *executionContext) marshalNCoin2githubᚗcomᚋwirelineioᚋwnsᚋgql
 	return ec._Coin(ctx, sel, &v)
 }
 
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v interface{}) (float64, error) {
+	return graphql.UnmarshalFloat(v)
+}
+
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+	return graphql.MarshalFloat(v)
+}
+
 func (ec *executionContext) unmarshalNKeyValueInput2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐKeyValueInput(ctx context.Context, v interface{}) ([]*KeyValueInput, error) {
 	var vSlice []interface{}
 	if v != nil {
@@ -5476,6 +9276,20 @@ func (ec *executionContext) unmarshalNKeyValueInput2ᚕᚖgithubᚗcomᚋwirelin
 	return res, nil
 }
 
+func (ec *executionContext) marshalNNameChange2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐNameChange(ctx context.Context, sel ast.SelectionSet, v NameChange) graphql.Marshaler {
+	return ec._NameChange(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNNameChange2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐNameChange(ctx context.Context, sel ast.SelectionSet, v *NameChange) graphql.Marshaler {
+	if v == nil {
+		if !ec.HasError(graphql.GetResolverContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._NameChange(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNNameRecord2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐNameRecord(ctx context.Context, sel ast.SelectionSet, v []*NameRecord) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
@@ -5535,6 +9349,47 @@ func (ec *executionContext) marshalNNodeInfo2githubᚗcomᚋwirelineioᚋwnsᚋg
 	return ec._NodeInfo(ctx, sel, &v)
 }
 
+func (ec *executionContext) marshalNProofOp2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐProofOp(ctx context.Context, sel ast.SelectionSet, v ProofOp) graphql.Marshaler {
+	return ec._ProofOp(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNProofOp2ᚕgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐProofOp(ctx context.Context, sel ast.SelectionSet, v []ProofOp) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNProofOp2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐProofOp(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
 func (ec *executionContext) marshalNRecord2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecord(ctx context.Context, sel ast.SelectionSet, v []*Record) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
@@ -5572,6 +9427,20 @@ func (ec *executionContext) marshalNRecord2ᚕᚖgithubᚗcomᚋwirelineioᚋwns
 	return ret
 }
 
+func (ec *executionContext) marshalNRecordChange2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecordChange(ctx context.Context, sel ast.SelectionSet, v RecordChange) graphql.Marshaler {
+	return ec._RecordChange(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNRecordChange2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecordChange(ctx context.Context, sel ast.SelectionSet, v *RecordChange) graphql.Marshaler {
+	if v == nil {
+		if !ec.HasError(graphql.GetResolverContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._RecordChange(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNRecordResult2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecordResult(ctx context.Context, sel ast.SelectionSet, v RecordResult) graphql.Marshaler {
 	return ec._RecordResult(ctx, sel, &v)
 }
@@ -5612,6 +9481,35 @@ func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.S
 	return graphql.MarshalString(v)
 }
 
+func (ec *executionContext) unmarshalNString2ᚕstring(ctx context.Context, v interface{}) ([]string, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNString2ᚕstring(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalNString2ᚕᚖstring(ctx context.Context, v interface{}) ([]*string, error) {
 	var vSlice []interface{}
 	if v != nil {
@@ -5952,6 +9850,134 @@ func (ec *executionContext) marshalOAccount2ᚖgithubᚗcomᚋwirelineioᚋwns
 	return ec._Account(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOAttributeFilterInput2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAttributeFilterInput(ctx context.Context, v interface{}) (AttributeFilterInput, error) {
+	return ec.unmarshalInputAttributeFilterInput(ctx, v)
+}
+
+func (ec *executionContext) unmarshalOAttributeFilterInput2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAttributeFilterInput(ctx context.Context, v interface{}) ([]*AttributeFilterInput, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]*AttributeFilterInput, len(vSlice))
+	for i := range vSlice {
+		res[i], err = ec.unmarshalOAttributeFilterInput2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAttributeFilterInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalOAttributeFilterInput2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAttributeFilterInput(ctx context.Context, v interface{}) (*AttributeFilterInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalOAttributeFilterInput2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAttributeFilterInput(ctx, v)
+	return &res, err
+}
+
+func (ec *executionContext) marshalOAuction2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAuction(ctx context.Context, sel ast.SelectionSet, v Auction) graphql.Marshaler {
+	return ec._Auction(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalOAuction2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAuction(ctx context.Context, sel ast.SelectionSet, v []*Auction) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOAuction2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAuction(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalOAuction2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAuction(ctx context.Context, sel ast.SelectionSet, v *Auction) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Auction(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOAuctionBid2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAuctionBid(ctx context.Context, sel ast.SelectionSet, v AuctionBid) graphql.Marshaler {
+	return ec._AuctionBid(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalOAuctionBid2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAuctionBid(ctx context.Context, sel ast.SelectionSet, v *AuctionBid) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._AuctionBid(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOAuthorityDelegate2ᚕgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAuthorityDelegate(ctx context.Context, sel ast.SelectionSet, v []AuthorityDelegate) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAuthorityDelegate2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAuthorityDelegate(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
 func (ec *executionContext) marshalOAuthorityRecord2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐAuthorityRecord(ctx context.Context, sel ast.SelectionSet, v AuthorityRecord) graphql.Marshaler {
 	return ec._AuthorityRecord(ctx, sel, &v)
 }
@@ -6034,6 +10060,34 @@ func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast
 	return ec.marshalOBoolean2bool(ctx, sel, *v)
 }
 
+func (ec *executionContext) unmarshalOBroadcastMode2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐBroadcastMode(ctx context.Context, v interface{}) (BroadcastMode, error) {
+	var res BroadcastMode
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalOBroadcastMode2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐBroadcastMode(ctx context.Context, sel ast.SelectionSet, v BroadcastMode) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalOBroadcastMode2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐBroadcastMode(ctx context.Context, v interface{}) (*BroadcastMode, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalOBroadcastMode2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐBroadcastMode(ctx, v)
+	return &res, err
+}
+
+func (ec *executionContext) marshalOBroadcastMode2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐBroadcastMode(ctx context.Context, sel ast.SelectionSet, v *BroadcastMode) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalOCoin2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐCoin(ctx context.Context, sel ast.SelectionSet, v Coin) graphql.Marshaler {
+	return ec._Coin(ctx, sel, &v)
+}
+
 func (ec *executionContext) marshalOCoin2ᚕgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐCoin(ctx context.Context, sel ast.SelectionSet, v []Coin) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
@@ -6071,6 +10125,37 @@ func (ec *executionContext) marshalOCoin2ᚕgithubᚗcomᚋwirelineioᚋwnsᚋgq
 	return ret
 }
 
+func (ec *executionContext) marshalOCoin2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐCoin(ctx context.Context, sel ast.SelectionSet, v *Coin) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Coin(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOFilterOperator2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐFilterOperator(ctx context.Context, v interface{}) (FilterOperator, error) {
+	var res FilterOperator
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalOFilterOperator2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐFilterOperator(ctx context.Context, sel ast.SelectionSet, v FilterOperator) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalOFilterOperator2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐFilterOperator(ctx context.Context, v interface{}) (*FilterOperator, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalOFilterOperator2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐFilterOperator(ctx, v)
+	return &res, err
+}
+
+func (ec *executionContext) marshalOFilterOperator2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐFilterOperator(ctx context.Context, sel ast.SelectionSet, v *FilterOperator) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) unmarshalOFloat2float64(ctx context.Context, v interface{}) (float64, error) {
 	return graphql.UnmarshalFloat(v)
 }
@@ -6304,6 +10389,65 @@ func (ec *executionContext) marshalOPeerInfo2ᚖgithubᚗcomᚋwirelineioᚋwns
 	return ec._PeerInfo(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOProof2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐProof(ctx context.Context, sel ast.SelectionSet, v Proof) graphql.Marshaler {
+	return ec._Proof(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalOProof2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐProof(ctx context.Context, sel ast.SelectionSet, v *Proof) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Proof(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalORPCNodeInfo2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRPCNodeInfo(ctx context.Context, sel ast.SelectionSet, v RPCNodeInfo) graphql.Marshaler {
+	return ec._RPCNodeInfo(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalORPCNodeInfo2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRPCNodeInfo(ctx context.Context, sel ast.SelectionSet, v []*RPCNodeInfo) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalORPCNodeInfo2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRPCNodeInfo(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalORPCNodeInfo2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRPCNodeInfo(ctx context.Context, sel ast.SelectionSet, v *RPCNodeInfo) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._RPCNodeInfo(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalORecord2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecord(ctx context.Context, sel ast.SelectionSet, v Record) graphql.Marshaler {
 	return ec._Record(ctx, sel, &v)
 }
@@ -6352,6 +10496,38 @@ func (ec *executionContext) marshalORecord2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋ
 	return ec._Record(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalORecordFilterInput2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecordFilterInput(ctx context.Context, v interface{}) (RecordFilterInput, error) {
+	return ec.unmarshalInputRecordFilterInput(ctx, v)
+}
+
+func (ec *executionContext) unmarshalORecordFilterInput2ᚕᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecordFilterInput(ctx context.Context, v interface{}) ([]*RecordFilterInput, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]*RecordFilterInput, len(vSlice))
+	for i := range vSlice {
+		res[i], err = ec.unmarshalORecordFilterInput2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecordFilterInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalORecordFilterInput2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecordFilterInput(ctx context.Context, v interface{}) (*RecordFilterInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalORecordFilterInput2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐRecordFilterInput(ctx, v)
+	return &res, err
+}
+
 func (ec *executionContext) marshalOReference2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐReference(ctx context.Context, sel ast.SelectionSet, v Reference) graphql.Marshaler {
 	return ec._Reference(ctx, sel, &v)
 }
@@ -6375,6 +10551,17 @@ func (ec *executionContext) unmarshalOReferenceInput2ᚖgithubᚗcomᚋwirelinei
 	return &res, err
 }
 
+func (ec *executionContext) marshalOStateProof2githubᚗcomᚋwirelineioᚋwnsᚋgqlᚐStateProof(ctx context.Context, sel ast.SelectionSet, v StateProof) graphql.Marshaler {
+	return ec._StateProof(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalOStateProof2ᚖgithubᚗcomᚋwirelineioᚋwnsᚋgqlᚐStateProof(ctx context.Context, sel ast.SelectionSet, v *StateProof) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._StateProof(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOString2string(ctx context.Context, v interface{}) (string, error) {
 	return graphql.UnmarshalString(v)
 }
@@ -0,0 +1,15 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package gql
+
+// NameRecord is the GQL name record (bound in gqlgen.yml, so that the name is available to the proof resolver).
+type NameRecord struct {
+	Latest  NameRecordEntry    `json:"latest"`
+	History []*NameRecordEntry `json:"history"`
+	Proof   *Proof             `json:"proof"`
+
+	// Name (WRN) of the record, not exposed.
+	Name string `json:"-"`
+}
@@ -2,6 +2,12 @@
 
 package gql
 
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
 type Account struct {
 	Address  string  `json:"address"`
 	PubKey   *string `json:"pubKey"`
@@ -10,10 +16,60 @@ type Account struct {
 	Balance  []Coin  `json:"balance"`
 }
 
+type AttributeFilterInput struct {
+	Key   string          `json:"key"`
+	Op    *FilterOperator `json:"op"`
+	Value ValueInput      `json:"value"`
+	Not   *bool           `json:"not"`
+}
+
+type Auction struct {
+	ID             string        `json:"id"`
+	Status         string        `json:"status"`
+	OwnerAddress   string        `json:"ownerAddress"`
+	CreateTime     string        `json:"createTime"`
+	CommitsEndTime string        `json:"commitsEndTime"`
+	RevealsEndTime string        `json:"revealsEndTime"`
+	MinimumBid     Coin          `json:"minimumBid"`
+	CommitDeposit  Coin          `json:"commitDeposit"`
+	WinnerAddress  *string       `json:"winnerAddress"`
+	WinningBid     *Coin         `json:"winningBid"`
+	WinningPrice   *Coin         `json:"winningPrice"`
+	Bids           []*AuctionBid `json:"bids"`
+}
+
+type AuctionBid struct {
+	BidderAddress string  `json:"bidderAddress"`
+	Status        string  `json:"status"`
+	CommitHash    string  `json:"commitHash"`
+	CommitTime    string  `json:"commitTime"`
+	RevealTime    *string `json:"revealTime"`
+	BidAmount     *Coin   `json:"bidAmount"`
+}
+
+type AuthorityChange struct {
+	Height    string           `json:"height"`
+	Name      string           `json:"name"`
+	Authority *AuthorityRecord `json:"authority"`
+}
+
+type AuthorityDelegate struct {
+	Address     string   `json:"address"`
+	Permissions []string `json:"permissions"`
+}
+
 type AuthorityRecord struct {
-	OwnerAddress   string `json:"ownerAddress"`
-	OwnerPublicKey string `json:"ownerPublicKey"`
-	Height         string `json:"height"`
+	OwnerAddress        string              `json:"ownerAddress"`
+	OwnerPublicKey      string              `json:"ownerPublicKey"`
+	Height              string              `json:"height"`
+	PendingOwnerAddress *string             `json:"pendingOwnerAddress"`
+	BondID              *string             `json:"bondId"`
+	ExpiryTime          *string             `json:"expiryTime"`
+	Status              string              `json:"status"`
+	AuctionID           *string             `json:"auctionId"`
+	Owners              []string            `json:"owners"`
+	Threshold           *int                `json:"threshold"`
+	Delegates           []AuthorityDelegate `json:"delegates"`
 }
 
 type AuthorityResult struct {
@@ -27,6 +83,11 @@ type Bond struct {
 	Balance []Coin `json:"balance"`
 }
 
+type BondResult struct {
+	Meta  ResultMeta `json:"meta"`
+	Bonds []*Bond    `json:"bonds"`
+}
+
 type Coin struct {
 	Type     string `json:"type"`
 	Quantity string `json:"quantity"`
@@ -42,9 +103,10 @@ type KeyValueInput struct {
 	Value ValueInput `json:"value"`
 }
 
-type NameRecord struct {
-	Latest  NameRecordEntry    `json:"latest"`
-	History []*NameRecordEntry `json:"history"`
+type NameChange struct {
+	Height string      `json:"height"`
+	Wrn    string      `json:"wrn"`
+	Record *NameRecord `json:"record"`
 }
 
 type NameRecordEntry struct {
@@ -69,15 +131,55 @@ type PeerInfo struct {
 	RemoteIP   string   `json:"remote_ip"`
 }
 
+type Proof struct {
+	Height  string    `json:"height"`
+	AppHash string    `json:"appHash"`
+	Key     string    `json:"key"`
+	Value   *string   `json:"value"`
+	Ops     []ProofOp `json:"ops"`
+}
+
+type ProofOp struct {
+	Type string `json:"type"`
+	Key  string `json:"key"`
+	Data string `json:"data"`
+}
+
+type RPCNodeInfo struct {
+	Address            string  `json:"address"`
+	Calls              string  `json:"calls"`
+	Errors             string  `json:"errors"`
+	VerificationErrors string  `json:"verification_errors"`
+	ErrorRate          float64 `json:"error_rate"`
+	Latency            string  `json:"latency"`
+	Score              float64 `json:"score"`
+	QuarantinedUntil   *string `json:"quarantined_until"`
+	LastCalledAt       *string `json:"last_called_at"`
+}
+
 type Record struct {
-	ID         string      `json:"id"`
-	Names      []string    `json:"names"`
-	BondID     string      `json:"bondId"`
-	CreateTime string      `json:"createTime"`
-	ExpiryTime string      `json:"expiryTime"`
-	Owners     []*string   `json:"owners"`
-	Attributes []*KeyValue `json:"attributes"`
-	References []*Record   `json:"references"`
+	ID            string      `json:"id"`
+	Names         []string    `json:"names"`
+	BondID        string      `json:"bondId"`
+	CreateTime    string      `json:"createTime"`
+	ExpiryTime    string      `json:"expiryTime"`
+	Owners        []*string   `json:"owners"`
+	Attributes    []*KeyValue `json:"attributes"`
+	References    []*Record   `json:"references"`
+	PredecessorID *string     `json:"predecessorId"`
+	SuccessorID   *string     `json:"successorId"`
+	Proof         *Proof      `json:"proof"`
+}
+
+type RecordChange struct {
+	Height string  `json:"height"`
+	ID     string  `json:"id"`
+	Record *Record `json:"record"`
+}
+
+type RecordFilterInput struct {
+	Attributes []*AttributeFilterInput `json:"attributes"`
+	Or         []*RecordFilterInput    `json:"or"`
 }
 
 type RecordResult struct {
@@ -94,7 +196,14 @@ type ReferenceInput struct {
 }
 
 type ResultMeta struct {
-	Height string `json:"height"`
+	Height     string      `json:"height"`
+	NextCursor *string     `json:"nextCursor"`
+	Proof      *StateProof `json:"proof"`
+}
+
+type StateProof struct {
+	Height  string `json:"height"`
+	AppHash string `json:"appHash"`
 }
 
 type Status struct {
@@ -106,6 +215,8 @@ type Status struct {
 	NumPeers   string           `json:"num_peers"`
 	Peers      []*PeerInfo      `json:"peers"`
 	DiskUsage  string           `json:"disk_usage"`
+	RPCNodes   []*RPCNodeInfo   `json:"rpc_nodes"`
+	LitePeers  []*RPCNodeInfo   `json:"lite_peers"`
 }
 
 type SyncInfo struct {
@@ -141,3 +252,99 @@ type ValueInput struct {
 	Reference *ReferenceInput `json:"reference"`
 	Values    []*ValueInput   `json:"values"`
 }
+
+type BroadcastMode string
+
+const (
+	BroadcastModeSync   BroadcastMode = "sync"
+	BroadcastModeAsync  BroadcastMode = "async"
+	BroadcastModeCommit BroadcastMode = "commit"
+)
+
+var AllBroadcastMode = []BroadcastMode{
+	BroadcastModeSync,
+	BroadcastModeAsync,
+	BroadcastModeCommit,
+}
+
+func (e BroadcastMode) IsValid() bool {
+	switch e {
+	case BroadcastModeSync, BroadcastModeAsync, BroadcastModeCommit:
+		return true
+	}
+	return false
+}
+
+func (e BroadcastMode) String() string {
+	return string(e)
+}
+
+func (e *BroadcastMode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BroadcastMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BroadcastMode", str)
+	}
+	return nil
+}
+
+func (e BroadcastMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type FilterOperator string
+
+const (
+	FilterOperatorEq       FilterOperator = "eq"
+	FilterOperatorGt       FilterOperator = "gt"
+	FilterOperatorGte      FilterOperator = "gte"
+	FilterOperatorLt       FilterOperator = "lt"
+	FilterOperatorLte      FilterOperator = "lte"
+	FilterOperatorPrefix   FilterOperator = "prefix"
+	FilterOperatorContains FilterOperator = "contains"
+	FilterOperatorIn       FilterOperator = "in"
+)
+
+var AllFilterOperator = []FilterOperator{
+	FilterOperatorEq,
+	FilterOperatorGt,
+	FilterOperatorGte,
+	FilterOperatorLt,
+	FilterOperatorLte,
+	FilterOperatorPrefix,
+	FilterOperatorContains,
+	FilterOperatorIn,
+}
+
+func (e FilterOperator) IsValid() bool {
+	switch e {
+	case FilterOperatorEq, FilterOperatorGt, FilterOperatorGte, FilterOperatorLt, FilterOperatorLte, FilterOperatorPrefix, FilterOperatorContains, FilterOperatorIn:
+		return true
+	}
+	return false
+}
+
+func (e FilterOperator) String() string {
+	return string(e)
+}
+
+func (e *FilterOperator) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FilterOperator(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FilterOperator", str)
+	}
+	return nil
+}
+
+func (e FilterOperator) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
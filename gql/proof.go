@@ -0,0 +1,238 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package gql
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmlite "github.com/tendermint/tendermint/lite"
+	tmliteProxy "github.com/tendermint/tendermint/lite/proxy"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/wirelineio/wns/x/nameservice"
+)
+
+// StoreQueryPath is the ABCI query path for (provable) nameservice store values.
+const StoreQueryPath = "/store/" + nameservice.StoreKey + "/key"
+
+// AppHashSource returns the trusted app hash of the state at the given height.
+type AppHashSource func(height int64) ([]byte, error)
+
+// NewCertifiedAppHashSource returns app hashes from headers certified by the light client verifier.
+// See https://godoc.org/github.com/tendermint/tendermint/lite/proxy#NewVerifier to create a verifier.
+func NewCertifiedAppHashSource(client rpcclient.Client, verifier tmlite.Verifier) AppHashSource {
+	return func(height int64) ([]byte, error) {
+		// The AppHash for height H is in header H+1.
+		commit, err := tmliteProxy.GetCertifiedCommit(height+1, client, verifier)
+		if err != nil {
+			return nil, err
+		}
+
+		return commit.Header.AppHash, nil
+	}
+}
+
+// GetGQLProof converts a merkle proof of the store value (nil for proofs of absence) at the given height.
+func GetGQLProof(height int64, appHash []byte, key []byte, value []byte, proof *merkle.Proof) *Proof {
+	ops := []ProofOp{}
+	if proof != nil {
+		for _, op := range proof.Ops {
+			ops = append(ops, ProofOp{
+				Type: op.Type,
+				Key:  base64.StdEncoding.EncodeToString(op.Key),
+				Data: base64.StdEncoding.EncodeToString(op.Data),
+			})
+		}
+	}
+
+	var encodedValue *string
+	if value != nil {
+		valueStr := base64.StdEncoding.EncodeToString(value)
+		encodedValue = &valueStr
+	}
+
+	return &Proof{
+		Height:  strconv.FormatInt(height, 10),
+		AppHash: hex.EncodeToString(appHash),
+		Key:     base64.StdEncoding.EncodeToString(key),
+		Value:   encodedValue,
+		Ops:     ops,
+	}
+}
+
+// ComputeAppHash computes the app hash the merkle proof of the store value (nil for proofs of absence) resolves to.
+func ComputeAppHash(proof *merkle.Proof, value []byte) ([]byte, error) {
+	if proof == nil {
+		return nil, errors.New("missing proof")
+	}
+
+	operators, err := rootmulti.DefaultProofRuntime().DecodeProof(proof)
+	if err != nil {
+		return nil, err
+	}
+
+	args := [][]byte{}
+	if value != nil {
+		args = append(args, value)
+	}
+
+	for _, op := range operators {
+		args, err = op.Run(args)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(args) != 1 {
+		return nil, errors.New("invalid proof")
+	}
+
+	return args[0], nil
+}
+
+// VerifyProof verifies the merkle proof against the trusted app hash for its height,
+// and returns the proven store key and value (nil if the key is proven to be absent).
+func VerifyProof(proof *Proof, appHashes AppHashSource) ([]byte, []byte, error) {
+	if proof == nil {
+		return nil, nil, errors.New("missing proof")
+	}
+
+	height, key, value, merkleProof, err := decodeProof(proof)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	appHash, err := appHashes(height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if proof.AppHash != hex.EncodeToString(appHash) {
+		return nil, nil, fmt.Errorf("app hash mismatch at height %d", height)
+	}
+
+	keyPath := merkle.KeyPath{}
+	keyPath = keyPath.AppendKey([]byte(nameservice.StoreKey), merkle.KeyEncodingURL)
+	keyPath = keyPath.AppendKey(key, merkle.KeyEncodingURL)
+
+	prt := rootmulti.DefaultProofRuntime()
+	if value == nil {
+		err = prt.VerifyAbsence(merkleProof, appHash, keyPath.String())
+	} else {
+		err = prt.VerifyValue(merkleProof, appHash, keyPath.String(), value)
+	}
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to verify merkle proof: %s", err)
+	}
+
+	return key, value, nil
+}
+
+// VerifyWRN verifies a resolved WRN end to end, without trusting the WNS node that served the proofs:
+// the name record proof (see `lookupNames`) must map the WRN to the record ID, the record proof (see `getRecordsByIds`)
+// must be for the record with that ID, and the record content must hash to the ID.
+// Note: Only registered names can be verified, not WRNs resolved using semver constraints.
+func VerifyWRN(wrn string, nameProof *Proof, recordProof *Proof, appHashes AppHashSource) (*nameservice.Record, error) {
+	key, value, err := VerifyProof(nameProof, appHashes)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(key, nameservice.GetNameRecordIndexKey(wrn)) {
+		return nil, fmt.Errorf("name proof is not for %s", wrn)
+	}
+
+	if value == nil {
+		return nil, fmt.Errorf("name %s is not registered", wrn)
+	}
+
+	var nameRecord nameservice.NameRecord
+	err = nameservice.ModuleCdc.UnmarshalBinaryBare(value, &nameRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	if nameRecord.ID == "" {
+		return nil, fmt.Errorf("name %s is not bound to a record", wrn)
+	}
+
+	key, value, err = VerifyProof(recordProof, appHashes)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(key, nameservice.GetRecordIndexKey(nameRecord.ID)) {
+		return nil, fmt.Errorf("record proof is not for %s", nameRecord.ID)
+	}
+
+	if value == nil {
+		return nil, fmt.Errorf("record %s not found", nameRecord.ID)
+	}
+
+	var recordObj nameservice.RecordObj
+	err = nameservice.ModuleCdc.UnmarshalBinaryBare(value, &recordObj)
+	if err != nil {
+		return nil, err
+	}
+
+	record := recordObj.ToRecord()
+	id, err := record.GetCID()
+	if err != nil {
+		return nil, err
+	}
+
+	if id != nameRecord.ID {
+		return nil, fmt.Errorf("record content does not match ID %s", nameRecord.ID)
+	}
+
+	if record.Deleted {
+		return nil, fmt.Errorf("record %s has been deleted", nameRecord.ID)
+	}
+
+	return &record, nil
+}
+
+func decodeProof(proof *Proof) (height int64, key []byte, value []byte, merkleProof *merkle.Proof, err error) {
+	height, err = strconv.ParseInt(proof.Height, 10, 64)
+	if err != nil {
+		return 0, nil, nil, nil, fmt.Errorf("invalid proof height: %s", proof.Height)
+	}
+
+	key, err = base64.StdEncoding.DecodeString(proof.Key)
+	if err != nil {
+		return 0, nil, nil, nil, fmt.Errorf("invalid proof key: %s", err)
+	}
+
+	if proof.Value != nil {
+		value, err = base64.StdEncoding.DecodeString(*proof.Value)
+		if err != nil {
+			return 0, nil, nil, nil, fmt.Errorf("invalid proof value: %s", err)
+		}
+	}
+
+	merkleProof = &merkle.Proof{}
+	for _, op := range proof.Ops {
+		opKey, err := base64.StdEncoding.DecodeString(op.Key)
+		if err != nil {
+			return 0, nil, nil, nil, fmt.Errorf("invalid proof op key: %s", err)
+		}
+
+		opData, err := base64.StdEncoding.DecodeString(op.Data)
+		if err != nil {
+			return 0, nil, nil, nil, fmt.Errorf("invalid proof op data: %s", err)
+		}
+
+		merkleProof.Ops = append(merkleProof.Ops, merkle.ProofOp{Type: op.Type, Key: opKey, Data: opData})
+	}
+
+	return height, key, value, merkleProof, nil
+}
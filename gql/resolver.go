@@ -18,8 +18,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/spf13/viper"
 	abci "github.com/tendermint/tendermint/abci/types"
 	rpctypes "github.com/tendermint/tendermint/rpc/lib/types"
+	"github.com/wirelineio/wns/x/auction"
 	"github.com/wirelineio/wns/x/bond"
 	"github.com/wirelineio/wns/x/nameservice"
 )
@@ -33,10 +35,13 @@ const MaxLogNumLines = 1000
 // Resolver is the GQL query resolver.
 type Resolver struct {
 	baseApp       *bam.BaseApp
+	cms           sdk.CommitMultiStore
 	codec         *codec.Codec
 	keeper        nameservice.Keeper
 	bondKeeper    bond.Keeper
+	auctionKeeper auction.Keeper
 	accountKeeper auth.AccountKeeper
+	publisher     *ChangesetPublisher
 	logFile       string
 }
 
@@ -50,6 +55,26 @@ func (r *Resolver) Query() QueryResolver {
 	return &queryResolver{r}
 }
 
+// Subscription is the entry point to subscriptions.
+func (r *Resolver) Subscription() SubscriptionResolver {
+	return &subscriptionResolver{r}
+}
+
+// NameRecord is the entry point to name record field resolution.
+func (r *Resolver) NameRecord() NameRecordResolver {
+	return &nameRecordResolver{r}
+}
+
+// Record is the entry point to record field resolution.
+func (r *Resolver) Record() RecordResolver {
+	return &recordResolver{r}
+}
+
+// ResultMeta is the entry point to result metadata field resolution.
+func (r *Resolver) ResultMeta() ResultMetaResolver {
+	return &resultMetaResolver{r}
+}
+
 type mutationResolver struct{ *Resolver }
 
 func (r *mutationResolver) InsertRecord(ctx context.Context, attributes []*KeyValueInput) (*Record, error) {
@@ -57,13 +82,19 @@ func (r *mutationResolver) InsertRecord(ctx context.Context, attributes []*KeyVa
 	return nil, errors.New("not implemented")
 }
 
-func (r *mutationResolver) Submit(ctx context.Context, tx string) (*string, error) {
-	stdTx, err := decodeStdTx(r.codec, tx)
+// Submit broadcasts the tx. Full-nodes ignore `waitForSync`, as they are always in sync with the blocks they commit.
+func (r *mutationResolver) Submit(ctx context.Context, tx string, mode *BroadcastMode, waitForSync *bool) (*string, error) {
+	stdTx, err := DecodeStdTx(r.codec, tx)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := broadcastTx(r, stdTx)
+	broadcastMode := BroadcastModeCommit
+	if mode != nil {
+		broadcastMode = *mode
+	}
+
+	res, err := broadcastTx(r, stdTx, broadcastMode)
 	if err != nil {
 		return nil, err
 	}
@@ -76,10 +107,62 @@ func (r *mutationResolver) Submit(ctx context.Context, tx string) (*string, erro
 
 type queryResolver struct{ *Resolver }
 
-func (r *queryResolver) GetRecordsByIds(ctx context.Context, ids []string) ([]*Record, error) {
+type subscriptionResolver struct{ *Resolver }
+
+func (r *subscriptionResolver) OnNameChanged(ctx context.Context, prefix *string) (<-chan *NameChange, error) {
+	return SubscribeNameChanges(ctx, &queryResolver{r.Resolver}, r.publisher, prefix)
+}
+
+func (r *subscriptionResolver) OnRecordChanged(ctx context.Context, attributes []*KeyValueInput) (<-chan *RecordChange, error) {
+	return SubscribeRecordChanges(ctx, &queryResolver{r.Resolver}, r.publisher, func(id nameservice.ID) *nameservice.Record {
+		sdkContext := r.baseApp.NewContext(true, abci.Header{})
+		if !r.keeper.HasRecord(sdkContext, id) {
+			return nil
+		}
+
+		record := r.keeper.GetRecord(sdkContext, id)
+
+		return &record
+	}, attributes)
+}
+
+func (r *subscriptionResolver) OnAuthorityChanged(ctx context.Context, prefix *string) (<-chan *AuthorityChange, error) {
+	return SubscribeAuthorityChanges(ctx, &queryResolver{r.Resolver}, r.publisher, prefix)
+}
+
+func (r *queryResolver) GetRecordsByIds(ctx context.Context, ids []string, height *string) ([]*Record, error) {
+	sdkContext, queryHeight, err := r.getContext(height)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = withQueryHeight(ctx, queryHeight)
 	records := make([]*Record, len(ids))
 	for index, id := range ids {
-		record, err := r.GetRecord(ctx, id)
+		record, err := r.getRecord(ctx, sdkContext, id)
+		if err != nil {
+			return nil, err
+		}
+
+		records[index] = record
+	}
+
+	return records, nil
+}
+
+// GetRecordVersions gets the version chain (oldest first) of a record.
+func (r *queryResolver) GetRecordVersions(ctx context.Context, id string) ([]*Record, error) {
+	sdkContext, queryHeight, err := r.getContext(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = withQueryHeight(ctx, queryHeight)
+
+	versions := r.keeper.GetRecordVersions(sdkContext, nameservice.ID(id))
+	records := make([]*Record, len(versions))
+	for index := range versions {
+		record, err := GetGQLRecord(ctx, r, &versions[index])
 		if err != nil {
 			return nil, err
 		}
@@ -91,14 +174,46 @@ func (r *queryResolver) GetRecordsByIds(ctx context.Context, ids []string) ([]*R
 }
 
 // QueryRecords filters records by K=V conditions.
-func (r *queryResolver) QueryRecords(ctx context.Context, attributes []*KeyValueInput, all *bool) ([]*Record, error) {
-	sdkContext := r.baseApp.NewContext(true, abci.Header{})
+func (r *queryResolver) QueryRecords(ctx context.Context, attributes []*KeyValueInput, filter *RecordFilterInput, all *bool, height *string) ([]*Record, error) {
+	result, err := r.QueryRecordsPage(ctx, attributes, filter, all, nil, nil, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Records, nil
+}
+
+// QueryRecordsPage filters records by K=V conditions, a page at a time.
+func (r *queryResolver) QueryRecordsPage(ctx context.Context, attributes []*KeyValueInput, filter *RecordFilterInput, all *bool, limit *int, cursor *string, height *string) (*RecordResult, error) {
+	sdkContext, queryHeight, err := r.getContext(height)
+	if err != nil {
+		return nil, err
+	}
 
-	var records = r.keeper.MatchRecords(sdkContext, func(record *nameservice.Record) bool {
-		return MatchOnAttributes(record, attributes, (all != nil && *all))
+	ctx = withQueryHeight(ctx, queryHeight)
+
+	pageLimit, pageCursor := GetPageParams(limit, cursor)
+	records, nextCursor, err := r.keeper.MatchRecords(sdkContext, GetIndexedAttributes(attributes, filter), pageCursor, pageLimit, func(record *nameservice.Record) bool {
+		return MatchOnAttributes(record, attributes, filter, (all != nil && *all))
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	gqlResponse, err := QueryRecords(ctx, r, records, attributes)
+	if err != nil {
+		return nil, err
+	}
 
-	return QueryRecords(ctx, r, records, attributes)
+	result := RecordResult{
+		Meta: ResultMeta{
+			Height:     strconv.FormatInt(queryHeight, 10),
+			NextCursor: GetNextCursor(nextCursor),
+		},
+		Records: gqlResponse,
+	}
+
+	return &result, nil
 }
 
 // QueryRecords filters records by K=V conditions.
@@ -118,8 +233,13 @@ func QueryRecords(ctx context.Context, resolver QueryResolver, records []*namese
 }
 
 // ResolveNames resolves records by name/WRN.
-func (r *queryResolver) ResolveNames(ctx context.Context, names []string) (*RecordResult, error) {
-	sdkContext := r.baseApp.NewContext(true, abci.Header{})
+func (r *queryResolver) ResolveNames(ctx context.Context, names []string, height *string) (*RecordResult, error) {
+	sdkContext, queryHeight, err := r.getContext(height)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = withQueryHeight(ctx, queryHeight)
 	gqlResponse := []*Record{}
 
 	for _, name := range names {
@@ -134,7 +254,7 @@ func (r *queryResolver) ResolveNames(ctx context.Context, names []string) (*Reco
 
 	result := RecordResult{
 		Meta: ResultMeta{
-			Height: strconv.FormatInt(r.baseApp.LastBlockHeight(), 10),
+			Height: strconv.FormatInt(queryHeight, 10),
 		},
 		Records: gqlResponse,
 	}
@@ -142,8 +262,13 @@ func (r *queryResolver) ResolveNames(ctx context.Context, names []string) (*Reco
 	return &result, nil
 }
 
-func (r *queryResolver) LookupAuthorities(ctx context.Context, names []string) (*AuthorityResult, error) {
-	sdkContext := r.baseApp.NewContext(true, abci.Header{})
+func (r *queryResolver) LookupAuthorities(ctx context.Context, names []string, height *string) (*AuthorityResult, error) {
+	sdkContext, queryHeight, err := r.getContext(height)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = withQueryHeight(ctx, queryHeight)
 	gqlResponse := []*AuthorityRecord{}
 
 	for _, name := range names {
@@ -158,7 +283,7 @@ func (r *queryResolver) LookupAuthorities(ctx context.Context, names []string) (
 
 	result := AuthorityResult{
 		Meta: ResultMeta{
-			Height: strconv.FormatInt(r.baseApp.LastBlockHeight(), 10),
+			Height: strconv.FormatInt(queryHeight, 10),
 		},
 		Records: gqlResponse,
 	}
@@ -166,13 +291,18 @@ func (r *queryResolver) LookupAuthorities(ctx context.Context, names []string) (
 	return &result, nil
 }
 
-func (r *queryResolver) LookupNames(ctx context.Context, names []string) (*NameResult, error) {
-	sdkContext := r.baseApp.NewContext(true, abci.Header{})
+func (r *queryResolver) LookupNames(ctx context.Context, names []string, height *string) (*NameResult, error) {
+	sdkContext, queryHeight, err := r.getContext(height)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = withQueryHeight(ctx, queryHeight)
 	gqlResponse := []*NameRecord{}
 
 	for _, name := range names {
 		record := r.keeper.GetNameRecord(sdkContext, name)
-		gqlRecord, err := GetGQLNameRecord(ctx, r, record)
+		gqlRecord, err := GetGQLNameRecord(ctx, r, name, record)
 		if err != nil {
 			return nil, err
 		}
@@ -182,7 +312,7 @@ func (r *queryResolver) LookupNames(ctx context.Context, names []string) (*NameR
 
 	result := NameResult{
 		Meta: ResultMeta{
-			Height: strconv.FormatInt(r.baseApp.LastBlockHeight(), 10),
+			Height: strconv.FormatInt(queryHeight, 10),
 		},
 		Records: gqlResponse,
 	}
@@ -190,6 +320,95 @@ func (r *queryResolver) LookupNames(ctx context.Context, names []string) (*NameR
 	return &result, nil
 }
 
+// getProof returns a merkle proof of the store value at the given height (latest state, if not set).
+func (r *Resolver) getProof(key []byte, height *string) (*Proof, error) {
+	var queryHeight int64
+	if height != nil {
+		var err error
+		queryHeight, err = strconv.ParseInt(*height, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid height: %s", *height)
+		}
+	}
+
+	res := r.baseApp.Query(abci.RequestQuery{Path: StoreQueryPath, Data: key, Height: queryHeight, Prove: true})
+	if res.IsErr() {
+		return nil, fmt.Errorf("error fetching proof: %s", res.GetLog())
+	}
+
+	appHash, err := ComputeAppHash(res.Proof, res.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetGQLProof(res.Height, appHash, key, res.Value, res.Proof), nil
+}
+
+type nameRecordResolver struct{ *Resolver }
+
+func (r *nameRecordResolver) Proof(ctx context.Context, obj *NameRecord) (*Proof, error) {
+	return r.getProof(nameservice.GetNameRecordIndexKey(obj.Name), GetQueryHeight(ctx))
+}
+
+type recordResolver struct{ *Resolver }
+
+func (r *recordResolver) Proof(ctx context.Context, obj *Record) (*Proof, error) {
+	return r.getProof(nameservice.GetRecordIndexKey(nameservice.ID(obj.ID)), GetQueryHeight(ctx))
+}
+
+type resultMetaResolver struct{ *Resolver }
+
+// Proof returns the app hash at the result height, which is the root of any (sub-)store proof at that height.
+func (r *resultMetaResolver) Proof(ctx context.Context, obj *ResultMeta) (*StateProof, error) {
+	height, err := strconv.ParseInt(obj.Height, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := r.getProof(nameservice.GetBlockChangesetIndexKey(height), &obj.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StateProof{Height: proof.Height, AppHash: proof.AppHash}, nil
+}
+
+// getContext returns a context for reading state at the given height (the latest committed height, if not set), and the height.
+// Proofs must be pinned to the returned height (see withQueryHeight), as the latest height can change in between.
+func (r *queryResolver) getContext(height *string) (sdk.Context, int64, error) {
+	latestHeight := r.baseApp.LastBlockHeight()
+	if height == nil && latestHeight == 0 {
+		return r.baseApp.NewContext(true, abci.Header{}), latestHeight, nil
+	}
+
+	queryHeight := latestHeight
+	if height != nil {
+		var err error
+		queryHeight, err = strconv.ParseInt(*height, 10, 64)
+		if err != nil || queryHeight <= 0 {
+			return sdk.Context{}, 0, fmt.Errorf("invalid height: %s", *height)
+		}
+	}
+
+	if queryHeight > latestHeight {
+		return sdk.Context{}, 0, fmt.Errorf("height %d is greater than the latest height %d", queryHeight, latestHeight)
+	}
+
+	cacheMS, err := r.cms.CacheMultiStoreWithVersion(queryHeight)
+	if err != nil {
+		return sdk.Context{}, 0, fmt.Errorf("state at height %d is not available, it might have been pruned (pruning strategy: %s)",
+			queryHeight, viper.GetString("pruning"))
+	}
+
+	return sdk.NewContext(cacheMS, abci.Header{Height: queryHeight}, true, r.baseApp.Logger()), queryHeight, nil
+}
+
+// withQueryHeight pins nested lookups and proofs to the height the query resolved to.
+func withQueryHeight(ctx context.Context, queryHeight int64) context.Context {
+	height := strconv.FormatInt(queryHeight, 10)
+	return WithQueryHeight(ctx, &height)
+}
+
 // GetLogs tails the log file.
 func GetLogs(ctx context.Context, logFile string, count *int) ([]string, error) {
 	if logFile == "" {
@@ -301,9 +520,7 @@ func (r *queryResolver) GetAccount(ctx context.Context, address string) (*Accoun
 	}, nil
 }
 
-func (r *queryResolver) GetRecord(ctx context.Context, id string) (*Record, error) {
-	sdkContext := r.baseApp.NewContext(true, abci.Header{})
-
+func (r *queryResolver) getRecord(ctx context.Context, sdkContext sdk.Context, id string) (*Record, error) {
 	dbID := nameservice.ID(id)
 	if r.keeper.HasRecord(sdkContext, dbID) {
 		record := r.keeper.GetRecord(sdkContext, dbID)
@@ -335,22 +552,62 @@ func (r *queryResolver) GetBond(ctx context.Context, id string) (*Bond, error) {
 	dbID := bond.ID(id)
 	if r.bondKeeper.HasBond(sdkContext, dbID) {
 		bondObj := r.bondKeeper.GetBond(sdkContext, dbID)
-		return getGQLBond(ctx, r, &bondObj)
+		return GetGQLBond(ctx, r, &bondObj)
+	}
+
+	return nil, nil
+}
+
+func (r *queryResolver) GetAuctionsByIds(ctx context.Context, ids []string) ([]*Auction, error) {
+	auctions := make([]*Auction, len(ids))
+	for index, id := range ids {
+		auctionObj, err := r.GetAuction(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		auctions[index] = auctionObj
+	}
+
+	return auctions, nil
+}
+
+func (r *queryResolver) GetAuction(ctx context.Context, id string) (*Auction, error) {
+	sdkContext := r.baseApp.NewContext(true, abci.Header{})
+
+	dbID := auction.ID(id)
+	if r.auctionKeeper.HasAuction(sdkContext, dbID) {
+		auctionObj := r.auctionKeeper.GetAuction(sdkContext, dbID)
+		bids := r.auctionKeeper.GetBids(sdkContext, dbID)
+		return getGQLAuction(ctx, r, &auctionObj, bids)
 	}
 
 	return nil, nil
 }
 
 func (r *queryResolver) QueryBonds(ctx context.Context, attributes []*KeyValueInput) ([]*Bond, error) {
+	result, err := r.QueryBondsPage(ctx, attributes, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Bonds, nil
+}
+
+func (r *queryResolver) QueryBondsPage(ctx context.Context, attributes []*KeyValueInput, limit *int, cursor *string) (*BondResult, error) {
 	sdkContext := r.baseApp.NewContext(true, abci.Header{})
 	gqlResponse := []*Bond{}
 
-	var bonds = r.bondKeeper.MatchBonds(sdkContext, func(bondObj *bond.Bond) bool {
-		return matchBondOnAttributes(bondObj, attributes)
+	pageLimit, pageCursor := GetPageParams(limit, cursor)
+	bonds, nextCursor, err := r.bondKeeper.MatchBonds(sdkContext, pageCursor, pageLimit, func(bondObj *bond.Bond) bool {
+		return MatchBondOnAttributes(bondObj, attributes)
 	})
+	if err != nil {
+		return nil, err
+	}
 
 	for _, bondObj := range bonds {
-		gqlBond, err := getGQLBond(ctx, r, bondObj)
+		gqlBond, err := GetGQLBond(ctx, r, bondObj)
 		if err != nil {
 			return nil, err
 		}
@@ -358,5 +615,13 @@ func (r *queryResolver) QueryBonds(ctx context.Context, attributes []*KeyValueIn
 		gqlResponse = append(gqlResponse, gqlBond)
 	}
 
-	return gqlResponse, nil
+	result := BondResult{
+		Meta: ResultMeta{
+			Height:     strconv.FormatInt(r.baseApp.LastBlockHeight(), 10),
+			NextCursor: GetNextCursor(nextCursor),
+		},
+		Bonds: gqlResponse,
+	}
+
+	return &result, nil
 }
@@ -5,14 +5,24 @@
 package gql
 
 import (
+	"context"
+	"errors"
 	"net/http"
+	"sync/atomic"
+	"time"
 
 	"github.com/spf13/viper"
 
 	"github.com/99designs/gqlgen/handler"
 	bam "github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/gorilla/websocket"
+	abci "github.com/tendermint/tendermint/abci/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"github.com/wirelineio/wns/x/auction"
 	"github.com/wirelineio/wns/x/bond"
 	"github.com/wirelineio/wns/x/nameservice"
 
@@ -20,8 +30,24 @@ import (
 	"github.com/rs/cors"
 )
 
+// ChangesetPollInterval is the interval for checking for new blocks (to publish changesets to subscriptions),
+// while not subscribed to NewBlock events.
+const ChangesetPollInterval = 1 * time.Second
+
+// SubscribedChangesetPollInterval is the interval for checking for new blocks (as a safety net) while subscribed to NewBlock events.
+const SubscribedChangesetPollInterval = 60 * time.Second
+
+// NewBlockSubscriber is the subscriber name for NewBlock events.
+const NewBlockSubscriber = "wnsd-gql"
+
+// NewBlockTimeout is the max. time without NewBlock events before the subscription is considered dropped.
+const NewBlockTimeout = 60 * time.Second
+
+// ResubscribeInterval is the wait duration before resubscribing to NewBlock events, after the subscription drops.
+const ResubscribeInterval = 10 * time.Second
+
 // Server configures and starts the GQL server.
-func Server(baseApp *bam.BaseApp, cdc *codec.Codec, keeper nameservice.Keeper, bondKeeper bond.Keeper, accountKeeper auth.AccountKeeper) {
+func Server(baseApp *bam.BaseApp, cms sdk.CommitMultiStore, cdc *codec.Codec, keeper nameservice.Keeper, bondKeeper bond.Keeper, auctionKeeper auction.Keeper, accountKeeper auth.AccountKeeper) {
 	if !viper.GetBool("gql-server") {
 		return
 	}
@@ -37,6 +63,12 @@ func Server(baseApp *bam.BaseApp, cdc *codec.Codec, keeper nameservice.Keeper, b
 
 	logFile := viper.GetString("log-file")
 
+	// Changesets are only published once there are subscriptions.
+	publisher := NewChangesetPublisher()
+	publisher.OnFirstSubscribe(func() {
+		go publishBlockChangesets(baseApp, cms, keeper, publisher)
+	})
+
 	if viper.GetBool("gql-playground") {
 		apiBase := viper.GetString("gql-playground-api-base")
 
@@ -48,25 +80,128 @@ func Server(baseApp *bam.BaseApp, cdc *codec.Codec, keeper nameservice.Keeper, b
 
 	router.Handle("/api", handler.GraphQL(NewExecutableSchema(Config{Resolvers: &Resolver{
 		baseApp:       baseApp,
+		cms:           cms,
 		codec:         cdc,
 		keeper:        keeper,
 		bondKeeper:    bondKeeper,
+		auctionKeeper: auctionKeeper,
 		accountKeeper: accountKeeper,
+		publisher:     publisher,
 		logFile:       logFile,
-	}})))
+	}}), WebsocketOptions()...))
 
 	// TODO(ashwin): Kept for backward compat.
 	router.Handle("/graphql", handler.GraphQL(NewExecutableSchema(Config{Resolvers: &Resolver{
 		baseApp:       baseApp,
+		cms:           cms,
 		codec:         cdc,
 		keeper:        keeper,
 		bondKeeper:    bondKeeper,
+		auctionKeeper: auctionKeeper,
 		accountKeeper: accountKeeper,
+		publisher:     publisher,
 		logFile:       logFile,
-	}})))
+	}}), WebsocketOptions()...))
 
 	err := http.ListenAndServe(":"+viper.GetString("gql-port"), router)
 	if err != nil {
 		panic(err)
 	}
 }
+
+// WebsocketOptions configures the websocket transport (used by subscriptions).
+func WebsocketOptions() []handler.Option {
+	return []handler.Option{
+		handler.WebsocketUpgrader(websocket.Upgrader{
+			// Same as the CORS policy.
+			CheckOrigin: func(r *http.Request) bool { return true },
+		}),
+	}
+}
+
+// publishBlockChangesets publishes the changesets of new blocks, as they are committed.
+// New blocks are notified by NewBlock events from the node, with polling as a fallback.
+func publishBlockChangesets(baseApp *bam.BaseApp, cms sdk.CommitMultiStore, keeper nameservice.Keeper, publisher *ChangesetPublisher) {
+	var subscribed int32
+	newBlocks := make(chan struct{}, 1)
+	go subscribeNewBlocks(baseApp, newBlocks, &subscribed)
+
+	lastHeight := baseApp.LastBlockHeight()
+
+	for {
+		pollInterval := ChangesetPollInterval
+		if atomic.LoadInt32(&subscribed) == 1 {
+			pollInterval = SubscribedChangesetPollInterval
+		}
+
+		select {
+		case <-newBlocks:
+		case <-time.After(pollInterval):
+		}
+
+		currentHeight := baseApp.LastBlockHeight()
+		if !publisher.HasSubscribers() {
+			lastHeight = currentHeight
+			continue
+		}
+
+		for ; lastHeight < currentHeight; lastHeight++ {
+			// Read the committed state at the height, not the check state (which CheckTx modifies concurrently).
+			cacheMS, err := cms.CacheMultiStoreWithVersion(lastHeight + 1)
+			if err != nil {
+				baseApp.Logger().Error("Error reading block changeset.", "height", lastHeight+1, "error", err.Error())
+				continue
+			}
+
+			sdkContext := sdk.NewContext(cacheMS, abci.Header{Height: lastHeight + 1}, true, baseApp.Logger())
+			changeset := keeper.GetBlockChangeset(sdkContext, lastHeight+1)
+			if changeset != nil {
+				publisher.Publish(changeset)
+			}
+		}
+	}
+}
+
+// subscribeNewBlocks notifies NewBlock events from the node's RPC server, resubscribing when the subscription drops.
+func subscribeNewBlocks(baseApp *bam.BaseApp, newBlocks chan struct{}, subscribed *int32) {
+	for {
+		err := watchNewBlocks(viper.GetString("rpc.laddr"), newBlocks, subscribed)
+		atomic.StoreInt32(subscribed, 0)
+		baseApp.Logger().Info("NewBlock subscription dropped, falling back to polling.", "error", err.Error())
+
+		time.Sleep(ResubscribeInterval)
+	}
+}
+
+// watchNewBlocks subscribes to NewBlock events, and notifies them until the subscription drops.
+func watchNewBlocks(address string, newBlocks chan struct{}, subscribed *int32) error {
+	// Use a dedicated client, as a stopped client can't be restarted.
+	client := rpcclient.NewHTTP(address, "/websocket")
+	err := client.Start()
+	if err != nil {
+		return err
+	}
+
+	defer client.Stop()
+
+	events, err := client.Subscribe(context.Background(), NewBlockSubscriber, tmtypes.EventQueryNewBlock.String())
+	if err != nil {
+		return err
+	}
+
+	atomic.StoreInt32(subscribed, 1)
+
+	// Note: The events channel is never closed (the client reconnects and resubscribes internally), so detect drops using a timeout.
+	for {
+		select {
+		case <-events:
+			select {
+			case newBlocks <- struct{}{}:
+			default:
+				// Already notified.
+			}
+		case <-time.After(NewBlockTimeout):
+			return errors.New("no new blocks received")
+		}
+	}
+}
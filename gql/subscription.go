@@ -0,0 +1,208 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package gql
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/wirelineio/wns/x/nameservice"
+)
+
+// SubscriptionBufferSize is the number of block changesets buffered per subscription.
+// Changesets are dropped for subscribers that fall further behind, so block production/sync is never held up.
+const SubscriptionBufferSize = 100
+
+// ChangesetPublisher fans out block changesets to GQL subscriptions.
+type ChangesetPublisher struct {
+	lock        sync.RWMutex
+	subscribers map[chan *nameservice.BlockChangeset]bool
+
+	// Called once, on the first subscription (see OnFirstSubscribe).
+	start     func()
+	startOnce sync.Once
+}
+
+// NewChangesetPublisher creates a changeset publisher.
+func NewChangesetPublisher() *ChangesetPublisher {
+	return &ChangesetPublisher{
+		subscribers: make(map[chan *nameservice.BlockChangeset]bool),
+	}
+}
+
+// OnFirstSubscribe sets a function that's called once, on the first subscription (e.g. to start publishing changesets).
+// Must be called before any subscriptions.
+func (p *ChangesetPublisher) OnFirstSubscribe(start func()) {
+	p.start = start
+}
+
+// HasSubscribers checks if there are any subscriptions.
+func (p *ChangesetPublisher) HasSubscribers() bool {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	return len(p.subscribers) > 0
+}
+
+// Subscribe returns a channel of block changesets, which is closed when the context is done.
+func (p *ChangesetPublisher) Subscribe(ctx context.Context) <-chan *nameservice.BlockChangeset {
+	changesets := make(chan *nameservice.BlockChangeset, SubscriptionBufferSize)
+
+	p.lock.Lock()
+	p.subscribers[changesets] = true
+	p.lock.Unlock()
+
+	if p.start != nil {
+		p.startOnce.Do(p.start)
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		p.lock.Lock()
+		delete(p.subscribers, changesets)
+		close(changesets)
+		p.lock.Unlock()
+	}()
+
+	return changesets
+}
+
+// Publish sends the changeset to all subscribers.
+func (p *ChangesetPublisher) Publish(changeset *nameservice.BlockChangeset) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	for subscriber := range p.subscribers {
+		select {
+		case subscriber <- changeset:
+		default:
+			// Slow subscriber, drop changeset.
+		}
+	}
+}
+
+// SubscribeNameChanges notifies changes to names with the given prefix.
+func SubscribeNameChanges(ctx context.Context, resolver QueryResolver, publisher *ChangesetPublisher, prefix *string) (<-chan *NameChange, error) {
+	changes := make(chan *NameChange)
+
+	go func() {
+		defer close(changes)
+
+		for changeset := range publisher.Subscribe(ctx) {
+			height := strconv.FormatInt(changeset.Height, 10)
+			for _, wrn := range changeset.Names {
+				if prefix != nil && !strings.HasPrefix(wrn, *prefix) {
+					continue
+				}
+
+				// Look up the name at the changeset height, as it might have changed again since.
+				result, err := resolver.LookupNames(ctx, []string{wrn}, &height)
+				if err != nil || len(result.Records) == 0 {
+					continue
+				}
+
+				change := &NameChange{
+					Height: height,
+					Wrn:    wrn,
+					Record: result.Records[0],
+				}
+
+				select {
+				case changes <- change:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return changes, nil
+}
+
+// SubscribeAuthorityChanges notifies changes to name authorities with the given prefix.
+func SubscribeAuthorityChanges(ctx context.Context, resolver QueryResolver, publisher *ChangesetPublisher, prefix *string) (<-chan *AuthorityChange, error) {
+	changes := make(chan *AuthorityChange)
+
+	go func() {
+		defer close(changes)
+
+		for changeset := range publisher.Subscribe(ctx) {
+			height := strconv.FormatInt(changeset.Height, 10)
+			for _, name := range changeset.NameAuthorities {
+				if prefix != nil && !strings.HasPrefix(name, *prefix) {
+					continue
+				}
+
+				// Look up the authority at the changeset height, as it might have changed again since.
+				result, err := resolver.LookupAuthorities(ctx, []string{name}, &height)
+				if err != nil || len(result.Records) == 0 {
+					continue
+				}
+
+				change := &AuthorityChange{
+					Height:    height,
+					Name:      name,
+					Authority: result.Records[0],
+				}
+
+				select {
+				case changes <- change:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return changes, nil
+}
+
+// SubscribeRecordChanges notifies changes to records matching the attributes.
+func SubscribeRecordChanges(ctx context.Context, resolver QueryResolver, publisher *ChangesetPublisher,
+	getRecord func(id nameservice.ID) *nameservice.Record, attributes []*KeyValueInput) (<-chan *RecordChange, error) {
+	changes := make(chan *RecordChange)
+
+	go func() {
+		defer close(changes)
+
+		for changeset := range publisher.Subscribe(ctx) {
+			for _, id := range changeset.Records {
+				record := getRecord(id)
+				if record == nil {
+					continue
+				}
+
+				// Deleted records still match on attributes, so that subscribers are notified of the deletion.
+				matchRecord := *record
+				matchRecord.Deleted = false
+				if !MatchOnAttributes(&matchRecord, attributes, nil, true) {
+					continue
+				}
+
+				gqlRecord, err := GetGQLRecord(ctx, resolver, record)
+				if err != nil {
+					continue
+				}
+
+				change := &RecordChange{
+					Height: strconv.FormatInt(changeset.Height, 10),
+					ID:     string(id),
+					Record: gqlRecord,
+				}
+
+				select {
+				case changes <- change:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return changes, nil
+}
@@ -7,15 +7,16 @@ package gql
 import (
 	"encoding/base64"
 	"errors"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/tendermint/go-amino"
 	"github.com/tendermint/tendermint/rpc/core"
-	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	rpctypes "github.com/tendermint/tendermint/rpc/lib/types"
 )
 
-func decodeStdTx(codec *amino.Codec, tx string) (*auth.StdTx, error) {
+// DecodeStdTx decodes the base64 encoded (JSON) tx blob created by https://github.com/wirelineio/registry-client.
+func DecodeStdTx(codec *amino.Codec, tx string) (*auth.StdTx, error) {
 	bytes, err := base64.StdEncoding.DecodeString(tx)
 	if err != nil {
 		return nil, err
@@ -30,13 +31,30 @@ func decodeStdTx(codec *amino.Codec, tx string) (*auth.StdTx, error) {
 	return &stdTx, nil
 }
 
-func broadcastTx(r *mutationResolver, stdTx *auth.StdTx) (*ctypes.ResultBroadcastTxCommit, error) {
+func broadcastTx(r *mutationResolver, stdTx *auth.StdTx, mode BroadcastMode) (interface{}, error) {
 	txBytes, err := r.Resolver.codec.MarshalBinaryLengthPrefixed(stdTx)
 	if err != nil {
 		return nil, err
 	}
 
 	ctx := &rpctypes.Context{}
+
+	switch mode {
+	case BroadcastModeSync:
+		res, err := core.BroadcastTxSync(ctx, txBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		if res.Code != 0 {
+			return nil, fmt.Errorf(`{"code":%d,"log":%q}`, res.Code, res.Log)
+		}
+
+		return res, nil
+	case BroadcastModeAsync:
+		return core.BroadcastTxAsync(ctx, txBytes)
+	}
+
 	res, err := core.BroadcastTxCommit(ctx, txBytes)
 	if err != nil {
 		return nil, err
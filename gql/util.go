@@ -10,6 +10,7 @@ import (
 	"reflect"
 	"strconv"
 
+	"github.com/wirelineio/wns/x/auction"
 	"github.com/wirelineio/wns/x/bond"
 	"github.com/wirelineio/wns/x/nameservice"
 
@@ -42,18 +43,30 @@ func GetGQLRecord(ctx context.Context, resolver QueryResolver, record *nameservi
 	}
 
 	return &Record{
-		ID:         string(record.ID),
-		Names:      record.Names,
-		BondID:     record.GetBondID(),
-		CreateTime: record.GetCreateTime(),
-		ExpiryTime: record.GetExpiryTime(),
-		Owners:     record.GetOwners(),
-		Attributes: attributes,
-		References: references,
+		ID:            string(record.ID),
+		Names:         record.Names,
+		BondID:        record.GetBondID(),
+		CreateTime:    record.GetCreateTime(),
+		ExpiryTime:    record.GetExpiryTime(),
+		Owners:        record.GetOwners(),
+		Attributes:    attributes,
+		References:    references,
+		PredecessorID: getOptionalID(record.Predecessor),
+		SuccessorID:   getOptionalID(record.Successor),
 	}, nil
 }
 
-func GetGQLNameRecord(ctx context.Context, resolver QueryResolver, record *nameservice.NameRecord) (*NameRecord, error) {
+func getOptionalID(id nameservice.ID) *string {
+	if id == "" {
+		return nil
+	}
+
+	value := string(id)
+
+	return &value
+}
+
+func GetGQLNameRecord(ctx context.Context, resolver QueryResolver, name string, record *nameservice.NameRecord) (*NameRecord, error) {
 	if record == nil {
 		return nil, nil
 	}
@@ -66,6 +79,7 @@ func GetGQLNameRecord(ctx context.Context, resolver QueryResolver, record *names
 	return &NameRecord{
 		Latest:  *getNameRecordEntry(record.NameRecordEntry),
 		History: records,
+		Name:    name,
 	}, nil
 }
 
@@ -81,10 +95,61 @@ func GetGQLNameAuthorityRecord(ctx context.Context, resolver QueryResolver, reco
 		return nil, nil
 	}
 
+	var pendingOwnerAddress *string
+	if record.PendingOwnerAddress != "" {
+		pendingOwnerAddress = &record.PendingOwnerAddress
+	}
+
+	var bondID *string
+	if record.BondID != "" {
+		bondIDStr := string(record.BondID)
+		bondID = &bondIDStr
+	}
+
+	var expiryTime *string
+	if !record.ExpiryTime.IsZero() {
+		expiryTimeStr := string(sdk.FormatTimeBytes(record.ExpiryTime))
+		expiryTime = &expiryTimeStr
+	}
+
+	var auctionID *string
+	if record.AuctionID != "" {
+		auctionIDStr := string(record.AuctionID)
+		auctionID = &auctionIDStr
+	}
+
+	var threshold *int
+	if len(record.Owners) > 0 {
+		thresholdInt := int(record.Threshold)
+		threshold = &thresholdInt
+	}
+
+	var delegates []AuthorityDelegate
+	for _, delegate := range record.Delegates {
+		delegates = append(delegates, AuthorityDelegate{
+			Address:     delegate.Address,
+			Permissions: delegate.Permissions,
+		})
+	}
+
+	status := record.Status
+	if status == "" {
+		// Authorities created before expiry was introduced.
+		status = nameservice.AuthorityActive
+	}
+
 	return &AuthorityRecord{
-		OwnerAddress:   record.OwnerAddress,
-		OwnerPublicKey: record.OwnerPublicKey,
-		Height:         strconv.FormatInt(record.Height, 10),
+		OwnerAddress:        record.OwnerAddress,
+		OwnerPublicKey:      record.OwnerPublicKey,
+		Height:              strconv.FormatInt(record.Height, 10),
+		PendingOwnerAddress: pendingOwnerAddress,
+		BondID:              bondID,
+		ExpiryTime:          expiryTime,
+		Status:              status,
+		AuctionID:           auctionID,
+		Owners:              record.Owners,
+		Threshold:           threshold,
+		Delegates:           delegates,
 	}, nil
 }
 
@@ -104,7 +169,27 @@ func getReferences(ctx context.Context, resolver QueryResolver, r *nameservice.R
 		}
 	}
 
-	return resolver.GetRecordsByIds(ctx, ids)
+	return resolver.GetRecordsByIds(ctx, ids, GetQueryHeight(ctx))
+}
+
+type queryHeightKey struct{}
+
+// WithQueryHeight pins nested lookups (e.g. record references) to the height of the query (if set).
+func WithQueryHeight(ctx context.Context, height *string) context.Context {
+	if height == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, queryHeightKey{}, *height)
+}
+
+// GetQueryHeight returns the height the query is pinned to (nil for the latest height).
+func GetQueryHeight(ctx context.Context) *string {
+	if height, ok := ctx.Value(queryHeightKey{}).(string); ok {
+		return &height
+	}
+
+	return nil
 }
 
 func getAttributes(r *nameservice.Record) ([]*KeyValue, error) {
@@ -175,33 +260,47 @@ func mapToKeyValuePairs(attrs map[string]interface{}) ([]*KeyValue, error) {
 	return kvPairs, nil
 }
 
-func matchOnRecordField(record *nameservice.Record, attr *KeyValueInput) (fieldFound bool, matched bool) {
-	fieldFound = false
-	matched = true
-
-	switch attr.Key {
-	case BondIDAttributeName:
-		{
-			fieldFound = true
-			if attr.Value.String == nil || record.GetBondID() != *attr.Value.String {
-				matched = false
-				return
-			}
+// GetIndexedAttributes gets the exact match conditions that can be looked up using the record attribute index.
+func GetIndexedAttributes(attributes []*KeyValueInput, filter *RecordFilterInput) map[string]interface{} {
+	indexedAttrs := map[string]interface{}{}
+
+	conditions := []*AttributeFilterInput{}
+	for _, attr := range attributes {
+		conditions = append(conditions, &AttributeFilterInput{Key: attr.Key, Value: attr.Value})
+	}
+
+	// Only top-level conditions are ANDed, OR groups can't be looked up in the index.
+	if filter != nil {
+		conditions = append(conditions, filter.Attributes...)
+	}
+
+	for _, attr := range conditions {
+		// Record struct fields aren't part of the attribute index.
+		if attr == nil || attr.Key == BondIDAttributeName || attr.Key == ExpiryTimeAttributeName || attr.Key == CreateTimeAttributeName {
+			continue
 		}
-	case ExpiryTimeAttributeName:
-		{
-			fieldFound = true
-			if attr.Value.String == nil || record.GetExpiryTime() != *attr.Value.String {
-				matched = false
-				return
-			}
+
+		if (attr.Op != nil && *attr.Op != FilterOperatorEq) || (attr.Not != nil && *attr.Not) {
+			continue
+		}
+
+		switch {
+		case attr.Value.String != nil:
+			indexedAttrs[attr.Key] = *attr.Value.String
+		case attr.Value.Boolean != nil:
+			indexedAttrs[attr.Key] = *attr.Value.Boolean
+		case attr.Value.Float != nil:
+			indexedAttrs[attr.Key] = *attr.Value.Float
+		case attr.Value.Reference != nil:
+			indexedAttrs[attr.Key] = map[string]interface{}{"/": attr.Value.Reference.ID}
 		}
 	}
 
-	return
+	return indexedAttrs
 }
 
-func MatchOnAttributes(record *nameservice.Record, attributes []*KeyValueInput, all bool) bool {
+// MatchOnAttributes checks if a record matches the K=V conditions and the filter (if any).
+func MatchOnAttributes(record *nameservice.Record, attributes []*KeyValueInput, filter *RecordFilterInput, all bool) bool {
 	// Filter deleted records.
 	if record.Deleted {
 		return false
@@ -212,93 +311,57 @@ func MatchOnAttributes(record *nameservice.Record, attributes []*KeyValueInput,
 		return false
 	}
 
-	recAttrs := record.Attributes
-
 	for _, attr := range attributes {
-		// First try matching on record struct fields.
-		fieldFound, matched := matchOnRecordField(record, attr)
-		if fieldFound {
-			if !matched {
-				return false
-			}
-
-			continue
-		}
-
-		recAttrVal, recAttrFound := recAttrs[attr.Key]
-		if !recAttrFound {
+		if !matchAttributeFilter(record, &AttributeFilterInput{Key: attr.Key, Value: attr.Value}) {
 			return false
 		}
+	}
 
-		if attr.Value.Int != nil {
-			recAttrValInt, ok := recAttrVal.(int)
-			if !ok || *attr.Value.Int != recAttrValInt {
-				return false
-			}
-		}
-
-		if attr.Value.Float != nil {
-			recAttrValFloat, ok := recAttrVal.(float64)
-			if !ok || *attr.Value.Float != recAttrValFloat {
-				return false
-			}
-		}
-
-		if attr.Value.String != nil {
-			recAttrValString, ok := recAttrVal.(string)
-			if !ok {
-				return false
-			}
-
-			if *attr.Value.String != recAttrValString {
-				return false
-			}
-		}
-
-		if attr.Value.Boolean != nil {
-			recAttrValBool, ok := recAttrVal.(bool)
-			if !ok || *attr.Value.Boolean != recAttrValBool {
-				return false
-			}
-		}
+	return filter == nil || matchRecordFilter(record, filter)
+}
 
-		if attr.Value.Reference != nil {
-			obj, ok := recAttrVal.(map[string]interface{})
-			if !ok {
-				// Attr value is not an object.
-				return false
-			}
+// GetPageParams gets the page limit and cursor from the (optional) query arguments.
+func GetPageParams(limit *int, cursor *string) (int, string) {
+	pageLimit := 0
+	if limit != nil {
+		pageLimit = *limit
+	}
 
-			if _, ok := obj["/"].(string); !ok {
-				// Attr value is not a reference.
-				return false
-			}
+	pageCursor := ""
+	if cursor != nil {
+		pageCursor = *cursor
+	}
 
-			recAttrValRefID := obj["/"].(string)
-			if recAttrValRefID != attr.Value.Reference.ID {
-				return false
-			}
-		}
+	return pageLimit, pageCursor
+}
 
-		// TODO(ashwin): Handle arrays.
+// GetNextCursor gets the next page cursor for the result metadata (nil if there are no more results).
+func GetNextCursor(nextCursor string) *string {
+	if nextCursor == "" {
+		return nil
 	}
 
-	return true
+	return &nextCursor
 }
 
 func getGQLCoins(coins sdk.Coins) []Coin {
 	gqlCoins := make([]Coin, len(coins))
 	for index, coin := range coins {
-		gqlCoins[index] = Coin{
-			Type:     coin.Denom,
-			Quantity: strconv.FormatInt(coin.Amount.Int64(), 10),
-		}
+		gqlCoins[index] = getGQLCoin(coin)
 	}
 
 	return gqlCoins
 }
 
-func getGQLBond(ctx context.Context, resolver *queryResolver, bondObj *bond.Bond) (*Bond, error) {
+func getGQLCoin(coin sdk.Coin) Coin {
+	return Coin{
+		Type:     coin.Denom,
+		Quantity: strconv.FormatInt(coin.Amount.Int64(), 10),
+	}
+}
+
+// GetGQLBond converts a bond to the GQL type.
+func GetGQLBond(ctx context.Context, resolver QueryResolver, bondObj *bond.Bond) (*Bond, error) {
 	// Nil record.
 	if bondObj == nil {
 		return nil, nil
@@ -311,7 +374,58 @@ func getGQLBond(ctx context.Context, resolver *queryResolver, bondObj *bond.Bond
 	}, nil
 }
 
-func matchBondOnAttributes(bondObj *bond.Bond, attributes []*KeyValueInput) bool {
+func getGQLAuction(ctx context.Context, resolver *queryResolver, auctionObj *auction.Auction, bids []auction.Bid) (*Auction, error) {
+	// Nil record.
+	if auctionObj == nil {
+		return nil, nil
+	}
+
+	gqlAuction := Auction{
+		ID:             string(auctionObj.ID),
+		Status:         auctionObj.Status,
+		OwnerAddress:   auctionObj.OwnerAddress,
+		CreateTime:     string(sdk.FormatTimeBytes(auctionObj.CreateTime)),
+		CommitsEndTime: string(sdk.FormatTimeBytes(auctionObj.CommitsEndTime)),
+		RevealsEndTime: string(sdk.FormatTimeBytes(auctionObj.RevealsEndTime)),
+		MinimumBid:     getGQLCoin(auctionObj.MinimumBid),
+		CommitDeposit:  getGQLCoin(auctionObj.CommitDeposit),
+		Bids:           []*AuctionBid{},
+	}
+
+	if auctionObj.WinnerAddress != "" {
+		winnerAddress := auctionObj.WinnerAddress
+		winningBid := getGQLCoin(auctionObj.WinningBid)
+		winningPrice := getGQLCoin(auctionObj.WinningPrice)
+
+		gqlAuction.WinnerAddress = &winnerAddress
+		gqlAuction.WinningBid = &winningBid
+		gqlAuction.WinningPrice = &winningPrice
+	}
+
+	for _, bid := range bids {
+		gqlBid := AuctionBid{
+			BidderAddress: bid.BidderAddress,
+			Status:        bid.Status,
+			CommitHash:    bid.CommitHash,
+			CommitTime:    string(sdk.FormatTimeBytes(bid.CommitTime)),
+		}
+
+		if bid.Status == auction.BidStatusRevealed {
+			revealTime := string(sdk.FormatTimeBytes(bid.RevealTime))
+			bidAmount := getGQLCoin(bid.BidAmount)
+
+			gqlBid.RevealTime = &revealTime
+			gqlBid.BidAmount = &bidAmount
+		}
+
+		gqlAuction.Bids = append(gqlAuction.Bids, &gqlBid)
+	}
+
+	return &gqlAuction, nil
+}
+
+// MatchBondOnAttributes checks if the bond matches the (owner) attributes.
+func MatchBondOnAttributes(bondObj *bond.Bond, attributes []*KeyValueInput) bool {
 	for _, attr := range attributes {
 		switch attr.Key {
 		case OwnerAttributeName:
@@ -7,6 +7,7 @@
 WNS_LITE_SERVER_CONFIG_DIR="${HOME}/.wire/wnsd-lite"
 CHAIN_ID="wireline"
 WNS_NODE_ADDRESS="tcp://localhost:26657"
+CROSS_CHECK_NODES=
 RESET=
 
 POSITIONAL=()
@@ -27,6 +28,11 @@ while [[ $# -gt 0 ]]; do
     shift
     shift
     ;;
+    --cross-check-nodes)
+    CROSS_CHECK_NODES="$2"
+    shift
+    shift
+    ;;
     *)
     POSITIONAL+=("$1")
     shift
@@ -43,7 +49,7 @@ function reset ()
 
 function init_node ()
 {
-  wnsd-lite init --chain-id "${CHAIN_ID}" --from-node --node "${WNS_NODE_ADDRESS}"
+  wnsd-lite init --chain-id "${CHAIN_ID}" --from-node --node "${WNS_NODE_ADDRESS}" --cross-check-nodes "${CROSS_CHECK_NODES}" "$@"
 }
 
 if [[ ! -z "${RESET}" ]]; then
@@ -0,0 +1,90 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package types
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EncodeCursor encodes a KV store key (relative to the prefix being iterated) as an opaque cursor.
+func EncodeCursor(key []byte) string {
+	return base64.RawURLEncoding.EncodeToString(key)
+}
+
+// DecodeCursor decodes a cursor into a KV store key (relative to the prefix being iterated).
+func DecodeCursor(cursor string) ([]byte, error) {
+	key, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+
+	return key, nil
+}
+
+// Paginate iterates over the KV pairs under prefix, starting at the cursor position.
+// The fn callback returns true if the KV pair was included in the page.
+// Iteration stops once limit KV pairs have been included (limit <= 0 means no limit).
+// Returns the cursor for the next page, which is empty if there are no more KV pairs.
+func Paginate(store sdk.KVStore, prefix []byte, cursor string, limit int, fn func(key []byte, value []byte) bool) (string, error) {
+	start, err := DecodeCursor(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	itr := store.Iterator(append(append([]byte{}, prefix...), start...), sdk.PrefixEndBytes(prefix))
+	defer itr.Close()
+
+	count := 0
+	for ; itr.Valid(); itr.Next() {
+		if limit > 0 && count == limit {
+			return EncodeCursor(itr.Key()[len(prefix):]), nil
+		}
+
+		if fn(itr.Key(), itr.Value()) {
+			count++
+		}
+	}
+
+	return "", nil
+}
+
+// ParsePageParams parses the optional `[limit]/[cursor]` querier path params.
+// Returns paginated = false if no params were passed.
+func ParsePageParams(path []string) (limit int, cursor string, paginated bool, err error) {
+	if len(path) == 0 || path[0] == "" {
+		return 0, "", false, nil
+	}
+
+	limit, err = strconv.Atoi(path[0])
+	if err != nil || limit < 0 {
+		return 0, "", false, errors.New("invalid limit")
+	}
+
+	if len(path) > 1 {
+		cursor = path[1]
+		if _, err = DecodeCursor(cursor); err != nil {
+			return 0, "", false, err
+		}
+	}
+
+	return limit, cursor, true, nil
+}
+
+// PageParamsPath generates the `[limit]/[cursor]` querier path suffix (empty if not paginated).
+func PageParamsPath(limit int, cursor string) string {
+	if limit <= 0 && cursor == "" {
+		return ""
+	}
+
+	if cursor == "" {
+		return "/" + strconv.Itoa(limit)
+	}
+
+	return "/" + strconv.Itoa(limit) + "/" + cursor
+}
@@ -0,0 +1,16 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package auction
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// EndBlocker is called every block, returns updated validator set.
+func EndBlocker(ctx sdk.Context, k Keeper) []abci.ValidatorUpdate {
+	k.ProcessAuctions(ctx)
+	return []abci.ValidatorUpdate{}
+}
@@ -0,0 +1,41 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package auction
+
+import (
+	"github.com/wirelineio/wns/x/auction/internal/keeper"
+	"github.com/wirelineio/wns/x/auction/internal/types"
+)
+
+const (
+	ModuleName = types.ModuleName
+	RouterKey  = types.RouterKey
+	StoreKey   = types.StoreKey
+
+	AuctionStatusCommitPhase = types.AuctionStatusCommitPhase
+	AuctionStatusRevealPhase = types.AuctionStatusRevealPhase
+	AuctionStatusCompleted   = types.AuctionStatusCompleted
+
+	BidStatusCommitted = types.BidStatusCommitted
+	BidStatusRevealed  = types.BidStatusRevealed
+)
+
+var (
+	DefaultParamspace = keeper.DefaultParamspace
+	NewKeeper         = keeper.NewKeeper
+	NewQuerier        = keeper.NewQuerier
+	ModuleCdc         = types.ModuleCdc
+	RegisterCodec     = types.RegisterCodec
+
+	RegisterInvariants = keeper.RegisterInvariants
+)
+
+type (
+	ID                  = types.ID
+	Auction             = types.Auction
+	Bid                 = types.Bid
+	Keeper              = keeper.Keeper
+	AuctionClientKeeper = keeper.AuctionClientKeeper
+)
@@ -0,0 +1,97 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/wirelineio/wns/x/auction/internal/types"
+)
+
+// GetTxCmd returns transaction commands for this module.
+func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
+	auctionTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Auction transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	auctionTxCmd.AddCommand(client.PostCommands(
+		GetCmdCommitBid(cdc),
+		GetCmdRevealBid(cdc),
+	)...)
+
+	return auctionTxCmd
+}
+
+// GetCmdCommitBid is the CLI command for committing a sealed bid.
+func GetCmdCommitBid(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-bid [auction ID] [amount] [salt]",
+		Short: "Commit sealed bid (the same amount and salt are required to reveal the bid, or the commit deposit is forfeited).",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			auctionID := args[0]
+			coin, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			commitHash := types.GenerateBidCommitHash(types.ID(auctionID), cliCtx.GetFromAddress(), coin, args[2])
+
+			msg := types.NewMsgCommitBid(auctionID, commitHash, cliCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdRevealBid is the CLI command for revealing a bid.
+func GetCmdRevealBid(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-bid [auction ID] [amount] [salt]",
+		Short: "Reveal bid.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			auctionID := args[0]
+			coin, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevealBid(auctionID, coin, args[2], cliCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,60 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package auction
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/wirelineio/wns/x/auction/internal/types"
+)
+
+type GenesisState struct {
+	Params   types.Params    `json:"params" yaml:"params"`
+	Auctions []types.Auction `json:"auctions" yaml:"auctions"`
+	Bids     []types.Bid     `json:"bids" yaml:"bids"`
+}
+
+func NewGenesisState(params types.Params, auctions []types.Auction, bids []types.Bid) GenesisState {
+	return GenesisState{Params: params, Auctions: auctions, Bids: bids}
+}
+
+func ValidateGenesis(data GenesisState) error {
+	err := data.Params.Validate()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func DefaultGenesisState() GenesisState {
+	return GenesisState{Params: types.DefaultParams()}
+}
+
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) []abci.ValidatorUpdate {
+	keeper.SetParams(ctx, data.Params)
+
+	for _, auction := range data.Auctions {
+		keeper.SaveAuction(ctx, auction)
+	}
+
+	for _, bid := range data.Bids {
+		keeper.SaveBid(ctx, bid)
+	}
+
+	return []abci.ValidatorUpdate{}
+}
+
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	params := keeper.GetParams(ctx)
+	auctions := keeper.ListAuctions(ctx)
+
+	bids := []types.Bid{}
+	for _, auction := range auctions {
+		bids = append(bids, keeper.GetBids(ctx, auction.ID)...)
+	}
+
+	return GenesisState{Params: params, Auctions: auctions, Bids: bids}
+}
@@ -0,0 +1,82 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package auction
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wirelineio/wns/x/auction/internal/types"
+)
+
+// NewHandler returns a handler for "auction" type messages.
+func NewHandler(keeper Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		switch msg := msg.(type) {
+		case types.MsgCommitBid:
+			return handleMsgCommitBid(ctx, keeper, msg)
+		case types.MsgRevealBid:
+			return handleMsgRevealBid(ctx, keeper, msg)
+		default:
+			errMsg := fmt.Sprintf("Unrecognized auction Msg type: %v", msg.Type())
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+// Handle MsgCommitBid.
+func handleMsgCommitBid(ctx sdk.Context, keeper Keeper, msg types.MsgCommitBid) sdk.Result {
+	bid, err := keeper.CommitBid(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCommitBid,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, string(bid.AuctionID)),
+			sdk.NewAttribute(types.AttributeKeyBidder, bid.BidderAddress),
+			sdk.NewAttribute(types.AttributeKeyCommitHash, bid.CommitHash),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer.String()),
+		),
+	})
+
+	return sdk.Result{
+		Data:   []byte(bid.AuctionID),
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// Handle MsgRevealBid.
+func handleMsgRevealBid(ctx sdk.Context, keeper Keeper, msg types.MsgRevealBid) sdk.Result {
+	bid, err := keeper.RevealBid(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevealBid,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, string(bid.AuctionID)),
+			sdk.NewAttribute(types.AttributeKeyBidder, bid.BidderAddress),
+			sdk.NewAttribute(types.AttributeKeyBidAmount, bid.BidAmount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer.String()),
+		),
+	})
+
+	return sdk.Result{
+		Data:   []byte(bid.AuctionID),
+		Events: ctx.EventManager().Events(),
+	}
+}
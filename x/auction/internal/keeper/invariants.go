@@ -0,0 +1,56 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wirelineio/wns/x/auction/internal/types"
+)
+
+// RegisterInvariants registers all auction module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "escrow", EscrowInvariant(k))
+}
+
+// EscrowInvariant checks that the 'auction' module account holds the revealed bids (and the commit deposits
+// of bids not revealed yet) of unsettled auctions.
+func EscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		escrowed := sdk.NewCoins()
+		for _, auction := range k.ListAuctions(ctx) {
+			if auction.Status == types.AuctionStatusCompleted {
+				continue
+			}
+
+			for _, bid := range k.GetBids(ctx, auction.ID) {
+				if bid.Status == types.BidStatusRevealed {
+					escrowed = escrowed.Add(sdk.NewCoins(bid.BidAmount))
+				} else {
+					escrowed = escrowed.Add(sdk.NewCoins(auction.CommitDeposit))
+				}
+			}
+		}
+
+		moduleAccount := k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName)
+		if !moduleAccount.GetCoins().IsAllGTE(escrowed) {
+			return sdk.FormatInvariant(
+					types.ModuleName,
+					"escrow",
+					fmt.Sprintf("Module account '%s' balance is lower than the escrowed bids and deposits (%s).", types.ModuleName, escrowed)),
+				true
+		}
+
+		return "", false
+	}
+}
+
+// AllInvariants runs all invariants of the auction module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return EscrowInvariant(k)(ctx)
+	}
+}
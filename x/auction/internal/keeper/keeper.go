@@ -0,0 +1,481 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/wirelineio/wns/x/auction/internal/types"
+)
+
+// prefixIDToAuctionIndex is the prefix for ID -> Auction index in the KVStore.
+// Note: This is the primary index in the system.
+// Note: Golang doesn't support const arrays.
+var prefixIDToAuctionIndex = []byte{0x00}
+
+// prefixAuctionToBidsIndex is the prefix for the Auction ID -> [Bid] index in the KVStore.
+var prefixAuctionToBidsIndex = []byte{0x01}
+
+// prefixBidderToAuctionsIndex is the prefix for the Bidder -> [Auction] index in the KVStore.
+var prefixBidderToAuctionsIndex = []byte{0x02}
+
+// prefixPendingAuctionsIndex is the prefix for the index of auctions that haven't been settled yet.
+var prefixPendingAuctionsIndex = []byte{0x03}
+
+// Keeper maintains the link to storage and exposes getter/setter methods for the various parts of the state machine
+type Keeper struct {
+	accountKeeper auth.AccountKeeper
+	bankKeeper    bank.Keeper
+	supplyKeeper  supply.Keeper
+
+	storeKey sdk.StoreKey // Unexposed key to access store from sdk.Context
+
+	cdc *codec.Codec // The wire codec for binary encoding/decoding.
+
+	paramstore params.Subspace
+}
+
+// AuctionClientKeeper is the subset of functionality exposed to other modules.
+type AuctionClientKeeper interface {
+	HasAuction(ctx sdk.Context, id types.ID) bool
+	GetAuction(ctx sdk.Context, id types.ID) types.Auction
+	CreateAuction(ctx sdk.Context, ownerAddress sdk.AccAddress) (*types.Auction, sdk.Error)
+}
+
+var _ AuctionClientKeeper = (*Keeper)(nil)
+
+// NewKeeper creates new instances of the auction Keeper
+func NewKeeper(accountKeeper auth.AccountKeeper, bankKeeper bank.Keeper, supplyKeeper supply.Keeper,
+	storeKey sdk.StoreKey, cdc *codec.Codec, paramstore params.Subspace) Keeper {
+	return Keeper{
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		supplyKeeper:  supplyKeeper,
+		storeKey:      storeKey,
+		cdc:           cdc,
+		paramstore:    paramstore.WithKeyTable(ParamKeyTable()),
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// Generates Auction ID -> Auction index key.
+func getAuctionIndexKey(id types.ID) []byte {
+	return append(prefixIDToAuctionIndex, []byte(id)...)
+}
+
+// Generates Auction ID -> Bid index key.
+func getBidIndexKey(auctionID types.ID, bidder string) []byte {
+	return append(getAuctionBidsIndexPrefix(auctionID), []byte(bidder)...)
+}
+
+// Generates Auction ID -> [Bid] index prefix.
+func getAuctionBidsIndexPrefix(auctionID types.ID) []byte {
+	return append(append(append([]byte{}, prefixAuctionToBidsIndex...), []byte(auctionID)...), 0x00)
+}
+
+// Generates Bidder -> [Auction] index key.
+func getBidderToAuctionsIndexKey(bidder string, auctionID types.ID) []byte {
+	return append(append(append([]byte{}, prefixBidderToAuctionsIndex...), []byte(bidder)...), []byte(auctionID)...)
+}
+
+// Generates pending auction index key.
+func getPendingAuctionIndexKey(id types.ID) []byte {
+	return append(prefixPendingAuctionsIndex, []byte(id)...)
+}
+
+// SaveAuction - saves an auction to the store.
+func (k Keeper) SaveAuction(ctx sdk.Context, auction types.Auction) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(getAuctionIndexKey(auction.ID), k.cdc.MustMarshalBinaryBare(auction))
+
+	// Track auctions that still need to move through the commit/reveal phases.
+	if auction.Status == types.AuctionStatusCompleted {
+		store.Delete(getPendingAuctionIndexKey(auction.ID))
+	} else {
+		store.Set(getPendingAuctionIndexKey(auction.ID), []byte{})
+	}
+}
+
+// HasAuction - checks if an auction by the given ID exists.
+func (k Keeper) HasAuction(ctx sdk.Context, id types.ID) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(getAuctionIndexKey(id))
+}
+
+// GetAuction - gets an auction from the store.
+func (k Keeper) GetAuction(ctx sdk.Context, id types.ID) types.Auction {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(getAuctionIndexKey(id))
+	var obj types.Auction
+	k.cdc.MustUnmarshalBinaryBare(bz, &obj)
+
+	return obj
+}
+
+// ListAuctions - get all auctions.
+func (k Keeper) ListAuctions(ctx sdk.Context) []types.Auction {
+	var auctions []types.Auction
+
+	store := ctx.KVStore(k.storeKey)
+	itr := sdk.KVStorePrefixIterator(store, prefixIDToAuctionIndex)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		bz := store.Get(itr.Key())
+		if bz != nil {
+			var obj types.Auction
+			k.cdc.MustUnmarshalBinaryBare(bz, &obj)
+			auctions = append(auctions, obj)
+		}
+	}
+
+	return auctions
+}
+
+// SaveBid - saves a bid to the store.
+func (k Keeper) SaveBid(ctx sdk.Context, bid types.Bid) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(getBidIndexKey(bid.AuctionID, bid.BidderAddress), k.cdc.MustMarshalBinaryBare(bid))
+	store.Set(getBidderToAuctionsIndexKey(bid.BidderAddress, bid.AuctionID), []byte{})
+}
+
+// HasBid - checks if a bid exists for the given auction and bidder.
+func (k Keeper) HasBid(ctx sdk.Context, auctionID types.ID, bidder string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(getBidIndexKey(auctionID, bidder))
+}
+
+// GetBid - gets a bid from the store.
+func (k Keeper) GetBid(ctx sdk.Context, auctionID types.ID, bidder string) types.Bid {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(getBidIndexKey(auctionID, bidder))
+	var obj types.Bid
+	k.cdc.MustUnmarshalBinaryBare(bz, &obj)
+
+	return obj
+}
+
+// GetBids - gets all the bids for an auction.
+func (k Keeper) GetBids(ctx sdk.Context, auctionID types.ID) []types.Bid {
+	var bids []types.Bid
+
+	store := ctx.KVStore(k.storeKey)
+	itr := sdk.KVStorePrefixIterator(store, getAuctionBidsIndexPrefix(auctionID))
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		var obj types.Bid
+		k.cdc.MustUnmarshalBinaryBare(itr.Value(), &obj)
+		bids = append(bids, obj)
+	}
+
+	return bids
+}
+
+// QueryAuctionsByBidder - query auctions by bidder.
+func (k Keeper) QueryAuctionsByBidder(ctx sdk.Context, bidderAddress string) []types.Auction {
+	var auctions []types.Auction
+
+	bidderPrefix := append(append([]byte{}, prefixBidderToAuctionsIndex...), []byte(bidderAddress)...)
+	store := ctx.KVStore(k.storeKey)
+	itr := sdk.KVStorePrefixIterator(store, bidderPrefix)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		auctionID := itr.Key()[len(bidderPrefix):]
+		bz := store.Get(getAuctionIndexKey(types.ID(auctionID)))
+		if bz != nil {
+			var obj types.Auction
+			k.cdc.MustUnmarshalBinaryBare(bz, &obj)
+			auctions = append(auctions, obj)
+		}
+	}
+
+	return auctions
+}
+
+// CreateAuction creates a new auction, starting with the commit phase.
+func (k Keeper) CreateAuction(ctx sdk.Context, ownerAddress sdk.AccAddress) (*types.Auction, sdk.Error) {
+	account := k.accountKeeper.GetAccount(ctx, ownerAddress)
+	if account == nil {
+		return nil, sdk.ErrUnknownAddress("Account not found.")
+	}
+
+	// Generate auction ID, the nonce is incremented for each auction created by the same tx (sequence).
+	auctionIDGenerator := types.AuctionID{
+		Address:  ownerAddress,
+		AccNum:   account.GetAccountNumber(),
+		Sequence: account.GetSequence(),
+	}
+
+	auctionID := auctionIDGenerator.Generate()
+	for k.HasAuction(ctx, types.ID(auctionID)) {
+		auctionIDGenerator.Nonce++
+		auctionID = auctionIDGenerator.Generate()
+	}
+
+	minimumBid, err := sdk.ParseCoin(k.MinimumBid(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal("Invalid minimum bid.")
+	}
+
+	commitDeposit, err := sdk.ParseCoin(k.CommitDeposit(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal("Invalid commit deposit.")
+	}
+
+	now := ctx.BlockHeader().Time
+	commitsEndTime := now.Add(k.CommitsDuration(ctx))
+
+	auction := types.Auction{
+		ID:             types.ID(auctionID),
+		Status:         types.AuctionStatusCommitPhase,
+		OwnerAddress:   ownerAddress.String(),
+		CreateTime:     now,
+		CommitsEndTime: commitsEndTime,
+		RevealsEndTime: commitsEndTime.Add(k.RevealsDuration(ctx)),
+		MinimumBid:     minimumBid,
+		WinningBid:     sdk.NewInt64Coin(minimumBid.Denom, 0),
+		WinningPrice:   sdk.NewInt64Coin(minimumBid.Denom, 0),
+		CommitDeposit:  commitDeposit,
+	}
+
+	k.SaveAuction(ctx, auction)
+
+	return &auction, nil
+}
+
+// CommitBid commits a sealed bid, replacing any earlier commit by the same bidder.
+// The commit deposit is moved into escrow on the first commit, and is forfeited if the bid isn't revealed.
+func (k Keeper) CommitBid(ctx sdk.Context, msg types.MsgCommitBid) (*types.Bid, sdk.Error) {
+	if !k.HasAuction(ctx, msg.AuctionID) {
+		return nil, sdk.ErrInternal("Auction not found.")
+	}
+
+	auction := k.GetAuction(ctx, msg.AuctionID)
+	if !ctx.BlockHeader().Time.Before(auction.CommitsEndTime) {
+		return nil, sdk.ErrInternal("Auction is not in commit phase.")
+	}
+
+	// The bidder must be an existing account, so that the escrowed funds can be refunded at settlement.
+	if k.accountKeeper.GetAccount(ctx, msg.Signer) == nil {
+		return nil, sdk.ErrUnknownAddress("Account not found.")
+	}
+
+	if !k.HasBid(ctx, msg.AuctionID, msg.Signer.String()) {
+		sdkErr := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, msg.Signer, types.ModuleName, sdk.NewCoins(auction.CommitDeposit))
+		if sdkErr != nil {
+			return nil, sdkErr
+		}
+	}
+
+	bid := types.Bid{
+		AuctionID:     msg.AuctionID,
+		BidderAddress: msg.Signer.String(),
+		Status:        types.BidStatusCommitted,
+		CommitHash:    msg.CommitHash,
+		CommitTime:    ctx.BlockHeader().Time,
+		BidAmount:     sdk.NewInt64Coin(auction.MinimumBid.Denom, 0),
+	}
+
+	k.SaveBid(ctx, bid)
+
+	return &bid, nil
+}
+
+// RevealBid reveals a sealed bid, moving the bid amount into escrow.
+func (k Keeper) RevealBid(ctx sdk.Context, msg types.MsgRevealBid) (*types.Bid, sdk.Error) {
+	if !k.HasAuction(ctx, msg.AuctionID) {
+		return nil, sdk.ErrInternal("Auction not found.")
+	}
+
+	auction := k.GetAuction(ctx, msg.AuctionID)
+	now := ctx.BlockHeader().Time
+	if now.Before(auction.CommitsEndTime) || !now.Before(auction.RevealsEndTime) {
+		return nil, sdk.ErrInternal("Auction is not in reveal phase.")
+	}
+
+	if !k.HasBid(ctx, msg.AuctionID, msg.Signer.String()) {
+		return nil, sdk.ErrInternal("Bid not found.")
+	}
+
+	bid := k.GetBid(ctx, msg.AuctionID, msg.Signer.String())
+	if bid.Status != types.BidStatusCommitted {
+		return nil, sdk.ErrInternal("Bid already revealed.")
+	}
+
+	if types.GenerateBidCommitHash(msg.AuctionID, msg.Signer, msg.Amount, msg.Salt) != bid.CommitHash {
+		return nil, sdk.ErrUnauthorized("Commit hash mismatch.")
+	}
+
+	if msg.Amount.Denom != auction.MinimumBid.Denom || msg.Amount.IsLT(auction.MinimumBid) {
+		return nil, sdk.ErrInternal("Bid is lower than the minimum bid.")
+	}
+
+	// Move the bid amount into escrow.
+	sdkErr := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, msg.Signer, types.ModuleName, sdk.NewCoins(msg.Amount))
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	// Refund the commit deposit.
+	sdkErr = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, msg.Signer, sdk.NewCoins(auction.CommitDeposit))
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	bid.Status = types.BidStatusRevealed
+	bid.RevealTime = now
+	bid.BidAmount = msg.Amount
+	k.SaveBid(ctx, bid)
+
+	return &bid, nil
+}
+
+// ProcessAuctions moves pending auctions through the reveal phase and settles them.
+func (k Keeper) ProcessAuctions(ctx sdk.Context) {
+	var auctionIDs []types.ID
+
+	store := ctx.KVStore(k.storeKey)
+	itr := sdk.KVStorePrefixIterator(store, prefixPendingAuctionsIndex)
+	for ; itr.Valid(); itr.Next() {
+		auctionIDs = append(auctionIDs, types.ID(itr.Key()[len(prefixPendingAuctionsIndex):]))
+	}
+	itr.Close()
+
+	now := ctx.BlockHeader().Time
+	for _, auctionID := range auctionIDs {
+		auction := k.GetAuction(ctx, auctionID)
+
+		if !now.Before(auction.RevealsEndTime) {
+			k.settleAuction(ctx, auction)
+			continue
+		}
+
+		if !now.Before(auction.CommitsEndTime) && auction.Status == types.AuctionStatusCommitPhase {
+			auction.Status = types.AuctionStatusRevealPhase
+			k.SaveAuction(ctx, auction)
+		}
+	}
+}
+
+// settleAuction picks the highest revealed bid as the winner, at the price of the second highest bid
+// (or the minimum bid, if there's only one). Losing bids are refunded, the winning price is burned,
+// as are the commit deposits of bids that weren't revealed.
+func (k Keeper) settleAuction(ctx sdk.Context, auction types.Auction) {
+	var revealedBids []types.Bid
+	forfeited := sdk.NewCoins()
+	forfeitedBids := 0
+	for _, bid := range k.GetBids(ctx, auction.ID) {
+		if bid.Status == types.BidStatusRevealed {
+			revealedBids = append(revealedBids, bid)
+		} else {
+			forfeitedBids++
+			forfeited = forfeited.Add(sdk.NewCoins(auction.CommitDeposit))
+		}
+	}
+
+	var highestBid, secondHighestBid *types.Bid
+	for index := range revealedBids {
+		bid := &revealedBids[index]
+		if highestBid == nil || isHigherBid(bid, highestBid) {
+			secondHighestBid = highestBid
+			highestBid = bid
+		} else if secondHighestBid == nil || isHigherBid(bid, secondHighestBid) {
+			secondHighestBid = bid
+		}
+	}
+
+	if highestBid != nil {
+		auction.WinnerAddress = highestBid.BidderAddress
+		auction.WinningBid = highestBid.BidAmount
+		auction.WinningPrice = auction.MinimumBid
+		if secondHighestBid != nil {
+			auction.WinningPrice = secondHighestBid.BidAmount
+		}
+	}
+
+	// Release escrowed funds.
+	for _, bid := range revealedBids {
+		refund := bid.BidAmount
+		if bid.BidderAddress == auction.WinnerAddress {
+			refund = bid.BidAmount.Sub(auction.WinningPrice)
+		}
+
+		if refund.IsZero() {
+			continue
+		}
+
+		// Bidder addresses are checked at commit, but a failed refund mustn't halt the chain, so it's logged and skipped.
+		bidderAddress, err := sdk.AccAddressFromBech32(bid.BidderAddress)
+		if err != nil {
+			k.Logger(ctx).Error("Invalid bidder address.", "auction", auction.ID, "bidder", bid.BidderAddress)
+			continue
+		}
+
+		sdkErr := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidderAddress, sdk.NewCoins(refund))
+		if sdkErr != nil {
+			k.Logger(ctx).Error("Error refunding bid.", "auction", auction.ID, "bidder", bid.BidderAddress, "error", sdkErr.Error())
+		}
+	}
+
+	burn := forfeited.Add(sdk.NewCoins(auction.WinningPrice))
+	if !burn.IsZero() {
+		sdkErr := k.supplyKeeper.BurnCoins(ctx, types.ModuleName, burn)
+		if sdkErr != nil {
+			k.Logger(ctx).Error("Error burning auction proceeds.", "auction", auction.ID, "amount", burn.String(), "error", sdkErr.Error())
+		}
+	}
+
+	auction.Status = types.AuctionStatusCompleted
+	k.SaveAuction(ctx, auction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionSettled,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, string(auction.ID)),
+			sdk.NewAttribute(types.AttributeKeyWinner, auction.WinnerAddress),
+			sdk.NewAttribute(types.AttributeKeyWinningBid, auction.WinningBid.String()),
+			sdk.NewAttribute(types.AttributeKeyWinningPrice, auction.WinningPrice.String()),
+			sdk.NewAttribute(types.AttributeKeyForfeitedBids, strconv.Itoa(forfeitedBids)),
+		),
+	)
+}
+
+// isHigherBid checks if a bid beats another, ties go to the earlier commit.
+func isHigherBid(bid *types.Bid, otherBid *types.Bid) bool {
+	if bid.BidAmount.IsEqual(otherBid.BidAmount) {
+		return bid.CommitTime.Before(otherBid.CommitTime)
+	}
+
+	return otherBid.BidAmount.IsLT(bid.BidAmount)
+}
+
+// GetAuctionModuleBalances gets the auction module account(s) balances.
+func (k Keeper) GetAuctionModuleBalances(ctx sdk.Context) map[string]sdk.Coins {
+	balances := map[string]sdk.Coins{}
+	accountNames := []string{types.ModuleName}
+
+	for _, accountName := range accountNames {
+		moduleAddress := k.supplyKeeper.GetModuleAddress(accountName)
+		moduleAccount := k.accountKeeper.GetAccount(ctx, moduleAddress)
+		if moduleAccount != nil {
+			balances[accountName] = moduleAccount.GetCoins()
+		}
+	}
+
+	return balances
+}
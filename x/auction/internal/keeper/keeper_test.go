@@ -0,0 +1,343 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+	"github.com/wirelineio/wns/x/auction/internal/types"
+)
+
+const testDenom = "uwire"
+
+var (
+	testCommitsDuration = time.Hour
+	testRevealsDuration = time.Hour
+	testStartTime       = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+type testInput struct {
+	ctx           sdk.Context
+	keeper        Keeper
+	accountKeeper auth.AccountKeeper
+	supplyKeeper  supply.Keeper
+}
+
+func createTestInput(t *testing.T) testInput {
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keyAuction := sdk.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyAuction, sdk.StoreTypeIAVL, db)
+	if err := ms.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+
+	cdc := codec.New()
+	auth.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	types.RegisterCodec(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "wireline", Time: testStartTime}, false, log.NewNopLogger())
+
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tkeyParams, params.DefaultCodespace)
+	accountKeeper := auth.NewAccountKeeper(cdc, keyAcc, paramsKeeper.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, paramsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, map[string]bool{})
+	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bankKeeper, map[string][]string{types.ModuleName: {supply.Burner}})
+	supplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins(sdk.NewInt64Coin(testDenom, 1000000000))))
+
+	keeper := NewKeeper(accountKeeper, bankKeeper, supplyKeeper, keyAuction, cdc, paramsKeeper.Subspace(DefaultParamspace))
+	keeper.SetParams(ctx, types.NewParams(testCommitsDuration, testRevealsDuration, "100uwire", "10uwire"))
+
+	return testInput{ctx: ctx, keeper: keeper, accountKeeper: accountKeeper, supplyKeeper: supplyKeeper}
+}
+
+func (input testInput) createAccount(balance int64) sdk.AccAddress {
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	account := input.accountKeeper.NewAccountWithAddress(input.ctx, sdk.AccAddress(pubKey.Address()))
+	account.SetPubKey(pubKey)
+	account.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(testDenom, balance)))
+	input.accountKeeper.SetAccount(input.ctx, account)
+
+	return account.GetAddress()
+}
+
+func (input testInput) balance(address sdk.AccAddress) int64 {
+	return input.accountKeeper.GetAccount(input.ctx, address).GetCoins().AmountOf(testDenom).Int64()
+}
+
+func (input testInput) moduleBalance() int64 {
+	return input.supplyKeeper.GetModuleAccount(input.ctx, types.ModuleName).GetCoins().AmountOf(testDenom).Int64()
+}
+
+func (input testInput) totalSupply() int64 {
+	return input.supplyKeeper.GetSupply(input.ctx).GetTotal().AmountOf(testDenom).Int64()
+}
+
+// atTime moves the block time to the given offset from the auction start.
+func (input *testInput) atTime(offset time.Duration) {
+	input.ctx = input.ctx.WithBlockTime(testStartTime.Add(offset))
+}
+
+func (input testInput) createAuction(t *testing.T) types.Auction {
+	auction, err := input.keeper.CreateAuction(input.ctx, input.createAccount(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return *auction
+}
+
+func (input testInput) commitBid(auctionID types.ID, bidder sdk.AccAddress, amount int64, salt string) sdk.Error {
+	commitHash := types.GenerateBidCommitHash(auctionID, bidder, sdk.NewInt64Coin(testDenom, amount), salt)
+	_, err := input.keeper.CommitBid(input.ctx, types.NewMsgCommitBid(string(auctionID), commitHash, bidder))
+
+	return err
+}
+
+func (input testInput) revealBid(auctionID types.ID, bidder sdk.AccAddress, amount int64, salt string) sdk.Error {
+	_, err := input.keeper.RevealBid(input.ctx, types.NewMsgRevealBid(string(auctionID), sdk.NewInt64Coin(testDenom, amount), salt, bidder))
+
+	return err
+}
+
+func (input testInput) checkEscrowInvariant(t *testing.T) {
+	if msg, broken := EscrowInvariant(input.keeper)(input.ctx); broken {
+		t.Fatal(msg)
+	}
+}
+
+func mustSucceed(t *testing.T, err sdk.Error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func mustFail(t *testing.T, err sdk.Error, message string) {
+	t.Helper()
+	if err == nil {
+		t.Fatalf("expected error: %s", message)
+	}
+}
+
+func TestCommitRevealPhases(t *testing.T) {
+	input := createTestInput(t)
+	auction := input.createAuction(t)
+	bidder := input.createAccount(1000)
+
+	mustFail(t, input.commitBid("unknown", bidder, 200, "salt"), "auction not found")
+	mustFail(t, input.commitBid(auction.ID, input.createAccount(5), 200, "salt"), "commit deposit not paid")
+	mustFail(t, input.commitBid(auction.ID, sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), 200, "salt"), "unknown bidder account")
+
+	mustSucceed(t, input.commitBid(auction.ID, bidder, 200, "salt"))
+	mustFail(t, input.revealBid(auction.ID, bidder, 200, "salt"), "reveal in commit phase")
+
+	// Committing again replaces the commit, without paying the deposit twice.
+	mustSucceed(t, input.commitBid(auction.ID, bidder, 300, "salt"))
+	if balance := input.balance(bidder); balance != 990 {
+		t.Fatalf("unexpected balance after commits: %d", balance)
+	}
+
+	input.atTime(testCommitsDuration)
+	mustFail(t, input.commitBid(auction.ID, bidder, 400, "salt"), "commit in reveal phase")
+	mustFail(t, input.revealBid(auction.ID, input.createAccount(1000), 300, "salt"), "bid not found")
+	mustFail(t, input.revealBid(auction.ID, bidder, 200, "salt"), "commit hash mismatch")
+	mustFail(t, input.revealBid(auction.ID, bidder, 300, "other"), "commit hash mismatch")
+
+	mustSucceed(t, input.revealBid(auction.ID, bidder, 300, "salt"))
+	mustFail(t, input.revealBid(auction.ID, bidder, 300, "salt"), "bid already revealed")
+
+	// The bid amount is escrowed, and the deposit refunded.
+	if balance := input.balance(bidder); balance != 700 {
+		t.Fatalf("unexpected balance after reveal: %d", balance)
+	}
+	input.checkEscrowInvariant(t)
+
+	input.atTime(testCommitsDuration + testRevealsDuration)
+	mustFail(t, input.revealBid(auction.ID, bidder, 300, "salt"), "reveal after reveal phase")
+}
+
+func TestRevealBelowMinimumBid(t *testing.T) {
+	input := createTestInput(t)
+	auction := input.createAuction(t)
+	bidder := input.createAccount(1000)
+
+	mustSucceed(t, input.commitBid(auction.ID, bidder, 50, "salt"))
+
+	input.atTime(testCommitsDuration)
+	mustFail(t, input.revealBid(auction.ID, bidder, 50, "salt"), "bid lower than minimum bid")
+}
+
+func TestSecondPriceSettlement(t *testing.T) {
+	input := createTestInput(t)
+	auction := input.createAuction(t)
+	bidders := []sdk.AccAddress{input.createAccount(1000), input.createAccount(1000), input.createAccount(1000)}
+	amounts := []int64{300, 500, 200}
+
+	for index, bidder := range bidders {
+		mustSucceed(t, input.commitBid(auction.ID, bidder, amounts[index], "salt"))
+	}
+	input.checkEscrowInvariant(t)
+
+	input.atTime(testCommitsDuration)
+	input.keeper.ProcessAuctions(input.ctx)
+	if status := input.keeper.GetAuction(input.ctx, auction.ID).Status; status != types.AuctionStatusRevealPhase {
+		t.Fatalf("unexpected auction status: %s", status)
+	}
+
+	for index, bidder := range bidders {
+		mustSucceed(t, input.revealBid(auction.ID, bidder, amounts[index], "salt"))
+	}
+	input.checkEscrowInvariant(t)
+
+	supplyBefore := input.totalSupply()
+
+	input.atTime(testCommitsDuration + testRevealsDuration)
+	input.keeper.ProcessAuctions(input.ctx)
+
+	settled := input.keeper.GetAuction(input.ctx, auction.ID)
+	if settled.Status != types.AuctionStatusCompleted {
+		t.Fatalf("unexpected auction status: %s", settled.Status)
+	}
+
+	// The highest bidder wins, at the price of the second highest bid.
+	if settled.WinnerAddress != bidders[1].String() {
+		t.Fatalf("unexpected winner: %s", settled.WinnerAddress)
+	}
+	if !settled.WinningBid.IsEqual(sdk.NewInt64Coin(testDenom, 500)) || !settled.WinningPrice.IsEqual(sdk.NewInt64Coin(testDenom, 300)) {
+		t.Fatalf("unexpected winning bid/price: %s/%s", settled.WinningBid, settled.WinningPrice)
+	}
+
+	// Losing bids are refunded in full, the winner is refunded the difference, and the price is burned.
+	for index, expected := range []int64{1000, 700, 1000} {
+		if balance := input.balance(bidders[index]); balance != expected {
+			t.Fatalf("unexpected balance for bidder %d: %d", index, balance)
+		}
+	}
+	if balance := input.moduleBalance(); balance != 0 {
+		t.Fatalf("unexpected module balance: %d", balance)
+	}
+	if burned := supplyBefore - input.totalSupply(); burned != 300 {
+		t.Fatalf("unexpected burned amount: %d", burned)
+	}
+	input.checkEscrowInvariant(t)
+}
+
+func TestSingleBidSettlement(t *testing.T) {
+	input := createTestInput(t)
+	auction := input.createAuction(t)
+	bidder := input.createAccount(1000)
+
+	mustSucceed(t, input.commitBid(auction.ID, bidder, 500, "salt"))
+
+	input.atTime(testCommitsDuration)
+	mustSucceed(t, input.revealBid(auction.ID, bidder, 500, "salt"))
+
+	input.atTime(testCommitsDuration + testRevealsDuration)
+	input.keeper.ProcessAuctions(input.ctx)
+
+	// A single bid wins at the minimum bid.
+	settled := input.keeper.GetAuction(input.ctx, auction.ID)
+	if settled.WinnerAddress != bidder.String() || !settled.WinningPrice.IsEqual(sdk.NewInt64Coin(testDenom, 100)) {
+		t.Fatalf("unexpected winner/price: %s/%s", settled.WinnerAddress, settled.WinningPrice)
+	}
+	if balance := input.balance(bidder); balance != 900 {
+		t.Fatalf("unexpected winner balance: %d", balance)
+	}
+	input.checkEscrowInvariant(t)
+}
+
+func TestForfeitedCommitDeposits(t *testing.T) {
+	input := createTestInput(t)
+	auction := input.createAuction(t)
+	revealer := input.createAccount(1000)
+	others := []sdk.AccAddress{input.createAccount(1000), input.createAccount(1000)}
+
+	mustSucceed(t, input.commitBid(auction.ID, revealer, 200, "salt"))
+	for _, bidder := range others {
+		mustSucceed(t, input.commitBid(auction.ID, bidder, 900, "salt"))
+	}
+	if balance := input.moduleBalance(); balance != 30 {
+		t.Fatalf("unexpected escrowed deposits: %d", balance)
+	}
+
+	input.atTime(testCommitsDuration)
+	mustSucceed(t, input.revealBid(auction.ID, revealer, 200, "salt"))
+	input.checkEscrowInvariant(t)
+
+	supplyBefore := input.totalSupply()
+
+	input.atTime(testCommitsDuration + testRevealsDuration)
+	input.keeper.ProcessAuctions(input.ctx)
+
+	// Unrevealed bids can't win, and their deposits are burned along with the winning price.
+	settled := input.keeper.GetAuction(input.ctx, auction.ID)
+	if settled.WinnerAddress != revealer.String() || !settled.WinningPrice.IsEqual(sdk.NewInt64Coin(testDenom, 100)) {
+		t.Fatalf("unexpected winner/price: %s/%s", settled.WinnerAddress, settled.WinningPrice)
+	}
+	if balance := input.balance(revealer); balance != 900 {
+		t.Fatalf("unexpected winner balance: %d", balance)
+	}
+	for _, bidder := range others {
+		if balance := input.balance(bidder); balance != 990 {
+			t.Fatalf("unexpected balance for unrevealed bidder: %d", balance)
+		}
+	}
+	if burned := supplyBefore - input.totalSupply(); burned != 120 {
+		t.Fatalf("unexpected burned amount: %d", burned)
+	}
+	if balance := input.moduleBalance(); balance != 0 {
+		t.Fatalf("unexpected module balance: %d", balance)
+	}
+	input.checkEscrowInvariant(t)
+}
+
+func TestSettlementWithoutReveals(t *testing.T) {
+	input := createTestInput(t)
+	auction := input.createAuction(t)
+	bidder := input.createAccount(1000)
+
+	mustSucceed(t, input.commitBid(auction.ID, bidder, 200, "salt"))
+
+	input.atTime(testCommitsDuration + testRevealsDuration)
+	input.keeper.ProcessAuctions(input.ctx)
+
+	// No winner, and the deposit is forfeited.
+	settled := input.keeper.GetAuction(input.ctx, auction.ID)
+	if settled.Status != types.AuctionStatusCompleted || settled.WinnerAddress != "" {
+		t.Fatalf("unexpected settlement: %s %s", settled.Status, settled.WinnerAddress)
+	}
+	if balance := input.balance(bidder); balance != 990 {
+		t.Fatalf("unexpected bidder balance: %d", balance)
+	}
+	if balance := input.moduleBalance(); balance != 0 {
+		t.Fatalf("unexpected module balance: %d", balance)
+	}
+	input.checkEscrowInvariant(t)
+}
@@ -0,0 +1,62 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/wirelineio/wns/x/auction/internal/types"
+)
+
+// Default parameter namespace.
+const (
+	DefaultParamspace = types.ModuleName
+)
+
+// ParamKeyTable - ParamTable for auction module.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&types.Params{})
+}
+
+// CommitsDuration - get the duration of the commit phase.
+func (k Keeper) CommitsDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyCommitsDuration, &res)
+	return
+}
+
+// RevealsDuration - get the duration of the reveal phase.
+func (k Keeper) RevealsDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyRevealsDuration, &res)
+	return
+}
+
+// MinimumBid - get the minimum bid.
+func (k Keeper) MinimumBid(ctx sdk.Context) (res string) {
+	k.paramstore.Get(ctx, types.KeyMinimumBid, &res)
+	return
+}
+
+// CommitDeposit - get the deposit paid to commit a bid.
+func (k Keeper) CommitDeposit(ctx sdk.Context) (res string) {
+	k.paramstore.Get(ctx, types.KeyCommitDeposit, &res)
+	return
+}
+
+// GetParams - Get all parameteras as types.Params.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(
+		k.CommitsDuration(ctx),
+		k.RevealsDuration(ctx),
+		k.MinimumBid(ctx),
+		k.CommitDeposit(ctx),
+	)
+}
+
+// SetParams - set the params.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramstore.SetParamSet(ctx, &params)
+}
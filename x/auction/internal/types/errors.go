@@ -0,0 +1,14 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultCodespace is the Module Name
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+)
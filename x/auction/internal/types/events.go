@@ -0,0 +1,25 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package types
+
+// Auction module event types.
+const (
+	EventTypeCommitBid = "commit-bid"
+	EventTypeRevealBid = "reveal-bid"
+
+	// Emitted by the EndBlocker when an auction is settled.
+	EventTypeAuctionSettled = "auction-settled"
+
+	AttributeKeyAuctionID     = "auction-id"
+	AttributeKeyBidder        = "bidder"
+	AttributeKeyCommitHash    = "commit-hash"
+	AttributeKeyBidAmount     = "bid-amount"
+	AttributeKeyWinner        = "winner"
+	AttributeKeyWinningBid    = "winning-bid"
+	AttributeKeyWinningPrice  = "winning-price"
+	AttributeKeyForfeitedBids = "forfeited-bids"
+
+	AttributeValueCategory = ModuleName
+)
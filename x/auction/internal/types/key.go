@@ -0,0 +1,13 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package types
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "auction"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+)
@@ -0,0 +1,114 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RouterKey is the module name router key
+const RouterKey = ModuleName // this was defined in your key.go file
+
+// MsgCommitBid defines a commit (sealed) bid message.
+type MsgCommitBid struct {
+	AuctionID  ID             `json:"auctionId"`
+	CommitHash string         `json:"commitHash"`
+	Signer     sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgCommitBid is the constructor function for MsgCommitBid.
+func NewMsgCommitBid(auctionID string, commitHash string, signer sdk.AccAddress) MsgCommitBid {
+	return MsgCommitBid{
+		AuctionID:  ID(auctionID),
+		CommitHash: commitHash,
+		Signer:     signer,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgCommitBid) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgCommitBid) Type() string { return "commit" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCommitBid) ValidateBasic() sdk.Error {
+
+	if string(msg.AuctionID) == "" {
+		return sdk.ErrInternal("Invalid auction ID.")
+	}
+
+	if msg.CommitHash == "" {
+		return sdk.ErrInternal("Invalid commit hash.")
+	}
+
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress(msg.Signer.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCommitBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCommitBid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgRevealBid defines a reveal bid message.
+type MsgRevealBid struct {
+	AuctionID ID             `json:"auctionId"`
+	Amount    sdk.Coin       `json:"amount"`
+	Salt      string         `json:"salt"`
+	Signer    sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgRevealBid is the constructor function for MsgRevealBid.
+func NewMsgRevealBid(auctionID string, amount sdk.Coin, salt string, signer sdk.AccAddress) MsgRevealBid {
+	return MsgRevealBid{
+		AuctionID: ID(auctionID),
+		Amount:    amount,
+		Salt:      salt,
+		Signer:    signer,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgRevealBid) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgRevealBid) Type() string { return "reveal" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRevealBid) ValidateBasic() sdk.Error {
+
+	if string(msg.AuctionID) == "" {
+		return sdk.ErrInternal("Invalid auction ID.")
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdk.ErrInvalidCoins("Invalid amount.")
+	}
+
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress(msg.Signer.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRevealBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRevealBid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
@@ -0,0 +1,103 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Auction params default values.
+const (
+	// DefaultCommitsDuration is the default duration of the commit phase (1 day).
+	DefaultCommitsDuration time.Duration = time.Hour * 24
+
+	// DefaultRevealsDuration is the default duration of the reveal phase (1 day).
+	DefaultRevealsDuration time.Duration = time.Hour * 24
+
+	// DefaultMinimumBid is the default minimum bid (also the price paid if there's a single bid).
+	DefaultMinimumBid string = "1000000uwire"
+
+	// DefaultCommitDeposit is the default deposit paid to commit a bid (refunded on reveal, forfeited otherwise).
+	DefaultCommitDeposit string = "100000uwire"
+)
+
+// nolint - Keys for parameter access
+var (
+	KeyCommitsDuration = []byte("CommitsDuration")
+	KeyRevealsDuration = []byte("RevealsDuration")
+	KeyMinimumBid      = []byte("MinimumBid")
+	KeyCommitDeposit   = []byte("CommitDeposit")
+)
+
+var _ params.ParamSet = (*Params)(nil)
+
+// Params defines the high level settings for the auction module.
+type Params struct {
+	CommitsDuration time.Duration `json:"commits_duration" yaml:"commits_duration"`
+	RevealsDuration time.Duration `json:"reveals_duration" yaml:"reveals_duration"`
+	MinimumBid      string        `json:"minimum_bid" yaml:"minimum_bid"`
+	CommitDeposit   string        `json:"commit_deposit" yaml:"commit_deposit"`
+}
+
+// NewParams creates a new Params instance
+func NewParams(commitsDuration time.Duration, revealsDuration time.Duration, minimumBid string, commitDeposit string) Params {
+	return Params{
+		CommitsDuration: commitsDuration,
+		RevealsDuration: revealsDuration,
+		MinimumBid:      minimumBid,
+		CommitDeposit:   commitDeposit,
+	}
+}
+
+// ParamSetPairs - implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: KeyCommitsDuration, Value: &p.CommitsDuration},
+		{Key: KeyRevealsDuration, Value: &p.RevealsDuration},
+		{Key: KeyMinimumBid, Value: &p.MinimumBid},
+		{Key: KeyCommitDeposit, Value: &p.CommitDeposit},
+	}
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultCommitsDuration, DefaultRevealsDuration, DefaultMinimumBid, DefaultCommitDeposit)
+}
+
+// String returns a human readable string representation of the parameters.
+func (p Params) String() string {
+	return fmt.Sprintf(`Params:
+  Commits Duration : %s
+  Reveals Duration : %s
+  Minimum Bid      : %s
+  Commit Deposit   : %s`, p.CommitsDuration, p.RevealsDuration, p.MinimumBid, p.CommitDeposit)
+}
+
+// Validate a set of params.
+func (p Params) Validate() error {
+	if p.CommitsDuration <= 0 {
+		return fmt.Errorf("auction parameter CommitsDuration must be a positive integer")
+	}
+
+	if p.RevealsDuration <= 0 {
+		return fmt.Errorf("auction parameter RevealsDuration must be a positive integer")
+	}
+
+	_, err := sdk.ParseCoin(p.MinimumBid)
+	if err != nil {
+		return err
+	}
+
+	_, err = sdk.ParseCoin(p.CommitDeposit)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
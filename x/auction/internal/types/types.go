@@ -0,0 +1,108 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Auction status values.
+const (
+	// AuctionStatusCommitPhase - auction is accepting bid commits.
+	AuctionStatusCommitPhase = "commit"
+
+	// AuctionStatusRevealPhase - auction is accepting bid reveals.
+	AuctionStatusRevealPhase = "reveal"
+
+	// AuctionStatusCompleted - auction has been settled.
+	AuctionStatusCompleted = "completed"
+)
+
+// Bid status values.
+const (
+	// BidStatusCommitted - bid has been committed (sealed).
+	BidStatusCommitted = "commit"
+
+	// BidStatusRevealed - bid has been revealed (and the bid amount escrowed).
+	BidStatusRevealed = "reveal"
+)
+
+// ID for auctions.
+type ID string
+
+// Auction is a sealed-bid (commit/reveal), second-price auction.
+type Auction struct {
+	ID     ID     `json:"id,omitempty"`
+	Status string `json:"status,omitempty"`
+
+	// Account that triggered the auction (e.g. by trying to reserve a name).
+	OwnerAddress string `json:"ownerAddress,omitempty"`
+
+	CreateTime     time.Time `json:"createTime,omitempty"`
+	CommitsEndTime time.Time `json:"commitsEndTime,omitempty"`
+	RevealsEndTime time.Time `json:"revealsEndTime,omitempty"`
+
+	MinimumBid sdk.Coin `json:"minimumBid"`
+
+	// Set at settlement, WinnerAddress is empty if there were no valid bids.
+	WinnerAddress string   `json:"winnerAddress,omitempty"`
+	WinningBid    sdk.Coin `json:"winningBid"`
+	WinningPrice  sdk.Coin `json:"winningPrice"`
+
+	// Deposit paid to commit a bid, refunded when the bid is revealed.
+	CommitDeposit sdk.Coin `json:"commitDeposit"`
+}
+
+// Bid represents a sealed bid (commit) made during the auction, revealed later.
+type Bid struct {
+	AuctionID     ID     `json:"auctionId,omitempty"`
+	BidderAddress string `json:"bidderAddress,omitempty"`
+	Status        string `json:"status,omitempty"`
+	CommitHash    string `json:"commitHash,omitempty"`
+
+	CommitTime time.Time `json:"commitTime,omitempty"`
+	RevealTime time.Time `json:"revealTime,omitempty"`
+
+	// Set when the bid is revealed.
+	BidAmount sdk.Coin `json:"bidAmount"`
+}
+
+// AuctionID simplifies generation of auction IDs.
+type AuctionID struct {
+	Address  sdk.Address
+	AccNum   uint64
+	Sequence uint64
+
+	// Distinguishes auctions created by the same tx (e.g. several reserve authority messages).
+	Nonce uint64
+}
+
+// Generate creates the auction ID.
+func (auctionID AuctionID) Generate() string {
+	hasher := sha256.New()
+	str := fmt.Sprintf("%s:%d:%d:%d", auctionID.Address.String(), auctionID.AccNum, auctionID.Sequence, auctionID.Nonce)
+	hasher.Write([]byte(str))
+	return hex.EncodeToString(hasher.Sum(nil))
+}
+
+// GenerateBidCommitHash generates the commit hash for a sealed bid.
+// The salt prevents other bidders from guessing the bid amount from the hash.
+func GenerateBidCommitHash(auctionID ID, bidderAddress sdk.AccAddress, amount sdk.Coin, salt string) string {
+	hasher := sha256.New()
+	str := fmt.Sprintf("%s:%s:%s:%s", auctionID, bidderAddress.String(), amount.String(), salt)
+	hasher.Write([]byte(str))
+	return hex.EncodeToString(hasher.Sum(nil))
+}
+
+// AuctionBids is an auction, along with its bids.
+type AuctionBids struct {
+	Auction Auction `json:"auction"`
+	Bids    []Bid   `json:"bids"`
+}
@@ -15,6 +15,17 @@ const (
 	StoreKey   = types.StoreKey
 )
 
+// Event types and attribute keys.
+const (
+	EventTypeCreateBond   = types.EventTypeCreateBond
+	EventTypeRefillBond   = types.EventTypeRefillBond
+	EventTypeWithdrawBond = types.EventTypeWithdrawBond
+	EventTypeCancelBond   = types.EventTypeCancelBond
+	AttributeKeyBondID    = types.AttributeKeyBondID
+	AttributeKeyOwner     = types.AttributeKeyOwner
+	AttributeKeyAmount    = types.AttributeKeyAmount
+)
+
 var (
 	DefaultParamspace = keeper.DefaultParamspace
 	NewKeeper         = keeper.NewKeeper
@@ -23,11 +34,23 @@ var (
 	RegisterCodec     = types.RegisterCodec
 
 	RegisterInvariants = keeper.RegisterInvariants
+
+	PrefixIDToBondIndex       = keeper.PrefixIDToBondIndex
+	PrefixBlockChangesetIndex = keeper.PrefixBlockChangesetIndex
+	GetBondIndexKey           = keeper.GetBondIndexKey
+	GetBlockChangesetIndexKey = keeper.GetBlockChangesetIndexKey
+	GetBlockChangeset         = keeper.GetBlockChangeset
+	HasBond                   = keeper.HasBond
+	GetBondByID               = keeper.GetBondByID
+	SaveBond                  = keeper.SaveBond
+	DeleteBond                = keeper.DeleteBond
+	MatchBonds                = keeper.MatchBonds
 )
 
 type (
 	ID               = types.ID
 	Bond             = types.Bond
+	BlockChangeset   = types.BlockChangeset
 	Keeper           = keeper.Keeper
 	BondUsageKeeper  = types.BondUsageKeeper
 	BondClientKeeper = keeper.BondClientKeeper
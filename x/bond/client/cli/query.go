@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	wnsTypes "github.com/wirelineio/wns/types"
 	"github.com/wirelineio/wns/x/bond/internal/types"
 )
 
@@ -38,7 +39,7 @@ func GetQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 
 // GetCmdList queries all bonds.
 func GetCmdList(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List bonds.",
 		Args:  cobra.ExactArgs(0),
@@ -47,7 +48,8 @@ func GetCmdList(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/list", queryRoute), nil)
+			pageParams := wnsTypes.PageParamsPath(viper.GetInt("limit"), viper.GetString("cursor"))
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/list%s", queryRoute, pageParams), nil)
 			if err != nil {
 				return err
 			}
@@ -57,6 +59,11 @@ func GetCmdList(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			return nil
 		},
 	}
+
+	cmd.Flags().Int("limit", 0, "Max number of results to return (paginates the results).")
+	cmd.Flags().String("cursor", "", "Cursor (from the previous page) to start returning results from.")
+
+	return cmd
 }
 
 // GetCmdGetBond queries a bond.
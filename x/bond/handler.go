@@ -47,6 +47,20 @@ func handleMsgCreateBond(ctx sdk.Context, keeper Keeper, msg types.MsgCreateBond
 		return err.Result()
 	}
 
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateBond,
+			sdk.NewAttribute(types.AttributeKeyBondID, string(bond.ID)),
+			sdk.NewAttribute(types.AttributeKeyOwner, bond.Owner),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Coins.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer.String()),
+		),
+	})
+
 	return sdk.Result{
 		Data:   []byte(bond.ID),
 		Events: ctx.EventManager().Events(),
@@ -60,6 +74,19 @@ func handleMsgRefillBond(ctx sdk.Context, keeper Keeper, msg types.MsgRefillBond
 		return err.Result()
 	}
 
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRefillBond,
+			sdk.NewAttribute(types.AttributeKeyBondID, string(bond.ID)),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Coins.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer.String()),
+		),
+	})
+
 	return sdk.Result{
 		Data:   []byte(bond.ID),
 		Events: ctx.EventManager().Events(),
@@ -73,6 +100,19 @@ func handleMsgWithdrawBond(ctx sdk.Context, keeper Keeper, msg types.MsgWithdraw
 		return err.Result()
 	}
 
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWithdrawBond,
+			sdk.NewAttribute(types.AttributeKeyBondID, string(bond.ID)),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Coins.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer.String()),
+		),
+	})
+
 	return sdk.Result{
 		Data:   []byte(bond.ID),
 		Events: ctx.EventManager().Events(),
@@ -86,6 +126,18 @@ func handleMsgCancelBond(ctx sdk.Context, keeper Keeper, msg types.MsgCancelBond
 		return err.Result()
 	}
 
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelBond,
+			sdk.NewAttribute(types.AttributeKeyBondID, string(bond.ID)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer.String()),
+		),
+	})
+
 	return sdk.Result{
 		Data:   []byte(bond.ID),
 		Events: ctx.EventManager().Events(),
@@ -13,17 +13,21 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/supply"
+	wnsTypes "github.com/wirelineio/wns/types"
 	"github.com/wirelineio/wns/x/bond/internal/types"
 )
 
-// prefixIDToBondIndex is the prefix for ID -> Bond index in the KVStore.
+// PrefixIDToBondIndex is the prefix for ID -> Bond index in the KVStore.
 // Note: This is the primary index in the system.
 // Note: Golang doesn't support const arrays.
-var prefixIDToBondIndex = []byte{0x00}
+var PrefixIDToBondIndex = []byte{0x00}
 
 // prefixOwnerToBondsIndex is the prefix for the Owner -> [Bond] index in the KVStore.
 var prefixOwnerToBondsIndex = []byte{0x01}
 
+// PrefixBlockChangesetIndex is the prefix for the block changeset (bonds changed at a height) index.
+var PrefixBlockChangesetIndex = []byte{0x02}
+
 // Keeper maintains the link to storage and exposes getter/setter methods for the various parts of the state machine
 type Keeper struct {
 	accountKeeper auth.AccountKeeper
@@ -44,8 +48,9 @@ type Keeper struct {
 type BondClientKeeper interface {
 	HasBond(ctx sdk.Context, id types.ID) bool
 	GetBond(ctx sdk.Context, id types.ID) types.Bond
-	MatchBonds(ctx sdk.Context, matchFn func(*types.Bond) bool) []*types.Bond
+	MatchBonds(ctx sdk.Context, cursor string, limit int, matchFn func(*types.Bond) bool) ([]*types.Bond, string, error)
 	TransferCoinsToModuleAccount(ctx sdk.Context, id types.ID, moduleAccount string, coins sdk.Coins) sdk.Error
+	TransferCoinsFromModuleAccount(ctx sdk.Context, id types.ID, moduleAccount string, coins sdk.Coins) sdk.Error
 	TranserCoinsToAccount(ctx sdk.Context, id types.ID, account sdk.AccAddress, coins sdk.Coins) sdk.Error
 }
 
@@ -65,9 +70,9 @@ func NewKeeper(accountKeeper auth.AccountKeeper, bankKeeper bank.Keeper, supplyK
 	}
 }
 
-// Generates Bond ID -> Bond index key.
-func getBondIndexKey(id types.ID) []byte {
-	return append(prefixIDToBondIndex, []byte(id)...)
+// GetBondIndexKey generates Bond ID -> Bond index key.
+func GetBondIndexKey(id types.ID) []byte {
+	return append(PrefixIDToBondIndex, []byte(id)...)
 }
 
 // Generates Owner -> Bonds index key.
@@ -77,10 +82,14 @@ func getOwnerToBondsIndexKey(owner string, bondID types.ID) []byte {
 
 // SaveBond - saves a bond to the store.
 func (k Keeper) SaveBond(ctx sdk.Context, bond types.Bond) {
-	store := ctx.KVStore(k.storeKey)
+	SaveBond(ctx.KVStore(k.storeKey), k.cdc, bond)
+	k.updateBlockChangesetForBond(ctx, bond.ID)
+}
 
+// SaveBond - saves a bond to the store and updates the Owner -> [Bond] index.
+func SaveBond(store sdk.KVStore, codec *codec.Codec, bond types.Bond) {
 	// Bond ID -> Bond index.
-	store.Set(getBondIndexKey(bond.ID), k.cdc.MustMarshalBinaryBare(bond))
+	store.Set(GetBondIndexKey(bond.ID), codec.MustMarshalBinaryBare(bond))
 
 	// Owner -> [Bond] index.
 	store.Set(getOwnerToBondsIndexKey(bond.Owner, bond.ID), []byte{})
@@ -88,45 +97,60 @@ func (k Keeper) SaveBond(ctx sdk.Context, bond types.Bond) {
 
 // HasBond - checks if a bond by the given ID exists.
 func (k Keeper) HasBond(ctx sdk.Context, id types.ID) bool {
-	store := ctx.KVStore(k.storeKey)
-	return store.Has(getBondIndexKey(id))
+	return HasBond(ctx.KVStore(k.storeKey), id)
+}
+
+// HasBond - checks if a bond by the given ID exists.
+func HasBond(store sdk.KVStore, id types.ID) bool {
+	return store.Has(GetBondIndexKey(id))
 }
 
 // DeleteBond - deletes the bond.
 func (k Keeper) DeleteBond(ctx sdk.Context, bond types.Bond) {
-	store := ctx.KVStore(k.storeKey)
-	store.Delete(getBondIndexKey(bond.ID))
+	DeleteBond(ctx.KVStore(k.storeKey), bond)
+	k.updateBlockChangesetForBond(ctx, bond.ID)
+}
+
+// DeleteBond - deletes the bond and its Owner -> [Bond] index entry.
+func DeleteBond(store sdk.KVStore, bond types.Bond) {
+	store.Delete(GetBondIndexKey(bond.ID))
 	store.Delete(getOwnerToBondsIndexKey(bond.Owner, bond.ID))
 }
 
 // GetBond - gets a record from the store.
 func (k Keeper) GetBond(ctx sdk.Context, id types.ID) types.Bond {
-	store := ctx.KVStore(k.storeKey)
+	return GetBondByID(ctx.KVStore(k.storeKey), k.cdc, id)
+}
 
-	bz := store.Get(getBondIndexKey(id))
+// GetBondByID - gets a bond from the store.
+func GetBondByID(store sdk.KVStore, codec *codec.Codec, id types.ID) types.Bond {
+	bz := store.Get(GetBondIndexKey(id))
 	var obj types.Bond
-	k.cdc.MustUnmarshalBinaryBare(bz, &obj)
+	codec.MustUnmarshalBinaryBare(bz, &obj)
 
 	return obj
 }
 
 // ListBonds - get all bonds.
 func (k Keeper) ListBonds(ctx sdk.Context) []types.Bond {
+	bonds, _, _ := k.ListBondsPage(ctx, "", 0)
+	return bonds
+}
+
+// ListBondsPage - get a page of bonds, starting at the cursor.
+func (k Keeper) ListBondsPage(ctx sdk.Context, cursor string, limit int) ([]types.Bond, string, error) {
 	var bonds []types.Bond
 
 	store := ctx.KVStore(k.storeKey)
-	itr := sdk.KVStorePrefixIterator(store, prefixIDToBondIndex)
-	defer itr.Close()
-	for ; itr.Valid(); itr.Next() {
-		bz := store.Get(itr.Key())
-		if bz != nil {
-			var obj types.Bond
-			k.cdc.MustUnmarshalBinaryBare(bz, &obj)
-			bonds = append(bonds, obj)
-		}
-	}
+	nextCursor, err := wnsTypes.Paginate(store, PrefixIDToBondIndex, cursor, limit, func(key []byte, bz []byte) bool {
+		var obj types.Bond
+		k.cdc.MustUnmarshalBinaryBare(bz, &obj)
+		bonds = append(bonds, obj)
 
-	return bonds
+		return true
+	})
+
+	return bonds, nextCursor, err
 }
 
 // QueryBondsByOwner - query bonds by owner.
@@ -139,7 +163,7 @@ func (k Keeper) QueryBondsByOwner(ctx sdk.Context, ownerAddress string) []types.
 	defer itr.Close()
 	for ; itr.Valid(); itr.Next() {
 		bondID := itr.Key()[len(ownerPrefix):]
-		bz := store.Get(append(prefixIDToBondIndex, bondID...))
+		bz := store.Get(append(PrefixIDToBondIndex, bondID...))
 		if bz != nil {
 			var obj types.Bond
 			k.cdc.MustUnmarshalBinaryBare(bz, &obj)
@@ -150,25 +174,27 @@ func (k Keeper) QueryBondsByOwner(ctx sdk.Context, ownerAddress string) []types.
 	return bonds
 }
 
-// MatchBonds - get all matching bonds.
-func (k Keeper) MatchBonds(ctx sdk.Context, matchFn func(*types.Bond) bool) []*types.Bond {
+// MatchBonds - get a page of matching bonds, starting at the cursor.
+func (k Keeper) MatchBonds(ctx sdk.Context, cursor string, limit int, matchFn func(*types.Bond) bool) ([]*types.Bond, string, error) {
+	return MatchBonds(ctx.KVStore(k.storeKey), k.cdc, cursor, limit, matchFn)
+}
+
+// MatchBonds - get a page of matching bonds, starting at the cursor.
+func MatchBonds(store sdk.KVStore, codec *codec.Codec, cursor string, limit int, matchFn func(*types.Bond) bool) ([]*types.Bond, string, error) {
 	var bonds []*types.Bond
 
-	store := ctx.KVStore(k.storeKey)
-	itr := sdk.KVStorePrefixIterator(store, prefixIDToBondIndex)
-	defer itr.Close()
-	for ; itr.Valid(); itr.Next() {
-		bz := store.Get(itr.Key())
-		if bz != nil {
-			var obj types.Bond
-			k.cdc.MustUnmarshalBinaryBare(bz, &obj)
-			if matchFn(&obj) {
-				bonds = append(bonds, &obj)
-			}
+	nextCursor, err := wnsTypes.Paginate(store, PrefixIDToBondIndex, cursor, limit, func(key []byte, bz []byte) bool {
+		var obj types.Bond
+		codec.MustUnmarshalBinaryBare(bz, &obj)
+		if matchFn(&obj) {
+			bonds = append(bonds, &obj)
+			return true
 		}
-	}
 
-	return bonds
+		return false
+	})
+
+	return bonds, nextCursor, err
 }
 
 // CreateBond creates a new bond.
@@ -349,11 +375,75 @@ func (k Keeper) TransferCoinsToModuleAccount(ctx sdk.Context, id types.ID, modul
 	return nil
 }
 
+// TransferCoinsFromModuleAccount moves funds from another module account back to the bond (e.g. rent refunds).
+func (k Keeper) TransferCoinsFromModuleAccount(ctx sdk.Context, id types.ID, moduleAccount string, coins sdk.Coins) sdk.Error {
+	if !k.HasBond(ctx, id) {
+		return sdk.ErrUnauthorized("Bond not found.")
+	}
+
+	bondObj := k.GetBond(ctx, id)
+
+	// Move funds from the other module back to the bond module.
+	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, moduleAccount, types.ModuleName, coins)
+	if err != nil {
+		return sdk.ErrInternal("Error transfering funds.")
+	}
+
+	// Update bond balance.
+	bondObj.Balance = bondObj.Balance.Add(coins)
+	k.SaveBond(ctx, bondObj)
+
+	return nil
+}
+
 // TranserCoinsToAccount moves coins from the bond to an account.
 func (k Keeper) TranserCoinsToAccount(ctx sdk.Context, id types.ID, account sdk.AccAddress, coins sdk.Coins) sdk.Error {
 	return sdk.ErrInternal("Not implemented.")
 }
 
+// GetBlockChangesetIndexKey generates the height -> bond changeset index key.
+func GetBlockChangesetIndexKey(height int64) []byte {
+	return append(PrefixBlockChangesetIndex, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetBlockChangeset gets the bond changeset for a block (nil if no bonds changed at that height).
+func (k Keeper) GetBlockChangeset(ctx sdk.Context, height int64) *types.BlockChangeset {
+	return GetBlockChangeset(ctx.KVStore(k.storeKey), k.cdc, height)
+}
+
+// GetBlockChangeset gets the bond changeset for a block (nil if no bonds changed at that height).
+func GetBlockChangeset(store sdk.KVStore, codec *codec.Codec, height int64) *types.BlockChangeset {
+	bz := store.Get(GetBlockChangesetIndexKey(height))
+	if bz == nil {
+		return nil
+	}
+
+	var changeset types.BlockChangeset
+	codec.MustUnmarshalBinaryBare(bz, &changeset)
+
+	return &changeset
+}
+
+// updateBlockChangesetForBond records the bond as changed at the current height, so that lite nodes can sync it.
+func (k Keeper) updateBlockChangesetForBond(ctx sdk.Context, id types.ID) {
+	changeset := k.GetBlockChangeset(ctx, ctx.BlockHeight())
+	if changeset == nil {
+		changeset = &types.BlockChangeset{Height: ctx.BlockHeight(), Bonds: []types.ID{}}
+	}
+
+	// Bonds are updated several times in a block (e.g. rent payments), record each only once.
+	for _, bondID := range changeset.Bonds {
+		if bondID == id {
+			return
+		}
+	}
+
+	changeset.Bonds = append(changeset.Bonds, id)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetBlockChangesetIndexKey(changeset.Height), k.cdc.MustMarshalBinaryBare(*changeset))
+}
+
 func (k Keeper) getMaxBondAmount(ctx sdk.Context) (sdk.Coins, error) {
 	maxBondAmount, err := sdk.ParseCoins(k.MaxBondAmount(ctx))
 	if err != nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+	wnsTypes "github.com/wirelineio/wns/types"
 	"github.com/wirelineio/wns/x/bond/internal/types"
 )
 
@@ -45,9 +46,22 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 
 // nolint: unparam
 func listBonds(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
-	bonds := keeper.ListBonds(ctx)
+	limit, cursor, paginated, err2 := wnsTypes.ParsePageParams(path)
+	if err2 != nil {
+		return nil, sdk.ErrUnknownRequest(err2.Error())
+	}
 
-	bz, err2 := json.MarshalIndent(bonds, "", "  ")
+	bonds, nextCursor, err2 := keeper.ListBondsPage(ctx, cursor, limit)
+	if err2 != nil {
+		return nil, sdk.ErrUnknownRequest(err2.Error())
+	}
+
+	var result interface{} = bonds
+	if paginated {
+		result = types.BondPage{Bonds: bonds, NextCursor: nextCursor}
+	}
+
+	bz, err2 := json.MarshalIndent(result, "", "  ")
 	if err2 != nil {
 		panic("Could not marshal result to JSON.")
 	}
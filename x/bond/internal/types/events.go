@@ -0,0 +1,19 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package types
+
+// Bond module event types.
+const (
+	EventTypeCreateBond   = "create-bond"
+	EventTypeRefillBond   = "refill-bond"
+	EventTypeWithdrawBond = "withdraw-bond"
+	EventTypeCancelBond   = "cancel-bond"
+
+	AttributeKeyBondID = "bond-id"
+	AttributeKeyOwner  = "owner"
+	AttributeKeyAmount = "amount"
+
+	AttributeValueCategory = ModuleName
+)
@@ -36,3 +36,15 @@ func (bondID BondID) Generate() string {
 	hasher.Write([]byte(str))
 	return hex.EncodeToString(hasher.Sum(nil))
 }
+
+// BondPage is a page of bonds, with the cursor for the next page.
+type BondPage struct {
+	Bonds      []Bond `json:"bonds"`
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+// BlockChangeset is the set of bonds changed (or deleted) in a block.
+type BlockChangeset struct {
+	Height int64 `json:"height"`
+	Bonds  []ID  `json:"bonds"`
+}
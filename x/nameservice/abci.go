@@ -13,5 +13,7 @@ import (
 // EndBlocker is called every block, returns updated validator set.
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 	k.ProcessRecordExpiryQueue(ctx)
+	k.ProcessAuthorityExpiryQueue(ctx)
+	k.ProcessAuthorityAuctions(ctx)
 	return []abci.ValidatorUpdate{}
 }
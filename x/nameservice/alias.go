@@ -10,10 +10,47 @@ import (
 )
 
 const (
-	ModuleName                  = types.ModuleName
-	RecordRentModuleAccountName = types.RecordRentModuleAccountName
-	RouterKey                   = types.RouterKey
-	StoreKey                    = types.StoreKey
+	ModuleName                     = types.ModuleName
+	AuthorityActive                = types.AuthorityActive
+	AuthorityExpired               = types.AuthorityExpired
+	AuthorityUnderAuction          = types.AuthorityUnderAuction
+	RecordRentModuleAccountName    = types.RecordRentModuleAccountName
+	AuthorityRentModuleAccountName = types.AuthorityRentModuleAccountName
+	RouterKey                      = types.RouterKey
+	StoreKey                       = types.StoreKey
+)
+
+// Event types and attribute keys.
+const (
+	EventTypeSetRecord            = types.EventTypeSetRecord
+	EventTypeRenewRecord          = types.EventTypeRenewRecord
+	EventTypeDeleteRecord         = types.EventTypeDeleteRecord
+	EventTypeAssociateBond        = types.EventTypeAssociateBond
+	EventTypeDissociateBond       = types.EventTypeDissociateBond
+	EventTypeDissociateRecords    = types.EventTypeDissociateRecords
+	EventTypeReassociateRecords   = types.EventTypeReassociateRecords
+	EventTypeReserveAuthority     = types.EventTypeReserveAuthority
+	EventTypeTransferAuthority    = types.EventTypeTransferAuthority
+	EventTypeAcceptAuthority      = types.EventTypeAcceptAuthority
+	EventTypeSetAuthorityBond     = types.EventTypeSetAuthorityBond
+	EventTypeSetAuthorityOwners   = types.EventTypeSetAuthorityOwners
+	EventTypeSetAuthorityDelegate = types.EventTypeSetAuthorityDelegate
+	EventTypeSetName              = types.EventTypeSetName
+	EventTypeDeleteName           = types.EventTypeDeleteName
+	EventTypeRecordRenewal        = types.EventTypeRecordRenewal
+	EventTypeRecordExpiry         = types.EventTypeRecordExpiry
+	AttributeKeyRecordID          = types.AttributeKeyRecordID
+	AttributeKeyBondID            = types.AttributeKeyBondID
+	AttributeKeyOldBondID         = types.AttributeKeyOldBondID
+	AttributeKeyNewBondID         = types.AttributeKeyNewBondID
+	AttributeKeyOwner             = types.AttributeKeyOwner
+	AttributeKeyNewOwner          = types.AttributeKeyNewOwner
+	AttributeKeyName              = types.AttributeKeyName
+	AttributeKeyWRN               = types.AttributeKeyWRN
+	AttributeKeyDelegate          = types.AttributeKeyDelegate
+	AttributeKeyPermission        = types.AttributeKeyPermission
+	AttributeKeyThreshold         = types.AttributeKeyThreshold
+	AttributeKeyUnlinkNames       = types.AttributeKeyUnlinkNames
 )
 
 var (
@@ -29,23 +66,32 @@ var (
 	PrefixCIDToRecordIndex         = keeper.PrefixCIDToRecordIndex
 	PrefixNameAuthorityRecordIndex = keeper.PrefixNameAuthorityRecordIndex
 	PrefixWRNToNameRecordIndex     = keeper.PrefixWRNToNameRecordIndex
+	PrefixAttributeToRecordsIndex  = keeper.PrefixAttributeToRecordsIndex
+	PrefixBlockChangesetIndex      = keeper.PrefixBlockChangesetIndex
 
 	GetBlockChangesetIndexKey = keeper.GetBlockChangesetIndexKey
 	GetRecordIndexKey         = keeper.GetRecordIndexKey
 	GetNameAuthorityIndexKey  = keeper.GetNameAuthorityIndexKey
 	GetNameRecordIndexKey     = keeper.GetNameRecordIndexKey
 
-	HasRecord        = keeper.HasRecord
-	GetRecord        = keeper.GetRecord
-	ResolveWRN       = keeper.ResolveWRN
-	GetNameAuthority = keeper.GetNameAuthority
-	GetNameRecord    = keeper.GetNameRecord
-	MatchRecords     = keeper.MatchRecords
-	KeySyncStatus    = keeper.KeySyncStatus
+	HasRecord                   = keeper.HasRecord
+	GetRecord                   = keeper.GetRecord
+	GetRecordVersions           = keeper.GetRecordVersions
+	ResolveWRN                  = keeper.ResolveWRN
+	GetNameAuthority            = keeper.GetNameAuthority
+	GetNameRecord               = keeper.GetNameRecord
+	MatchRecords                = keeper.MatchRecords
+	KeySyncStatus               = keeper.KeySyncStatus
+	PrefixStoreKeyToProofIndex  = keeper.PrefixStoreKeyToProofIndex
+	KeySyncFilter               = keeper.KeySyncFilter
+	PrefixBondStore             = keeper.PrefixBondStore
+	PrefixHeightToUndoDataIndex = keeper.PrefixHeightToUndoDataIndex
 
 	SetNameRecord             = keeper.SetNameRecord
 	AddRecordToNameMapping    = keeper.AddRecordToNameMapping
 	RemoveRecordToNameMapping = keeper.RemoveRecordToNameMapping
+	AddRecordToAttributeIndex = keeper.AddRecordToAttributeIndex
+	GetRecordIDsByAttribute   = keeper.GetRecordIDsByAttribute
 )
 
 type (
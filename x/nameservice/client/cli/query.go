@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	wnsTypes "github.com/wirelineio/wns/types"
 	"github.com/wirelineio/wns/x/nameservice/internal/types"
 )
 
@@ -88,7 +89,7 @@ func GetCmdLookupWRN(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 // GetCmdList queries all records.
 func GetCmdList(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List records.",
 		Args:  cobra.ExactArgs(0),
@@ -97,7 +98,8 @@ func GetCmdList(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/list", queryRoute), nil)
+			pageParams := wnsTypes.PageParamsPath(viper.GetInt("limit"), viper.GetString("cursor"))
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/list%s", queryRoute, pageParams), nil)
 			if err != nil {
 				return err
 			}
@@ -107,6 +109,11 @@ func GetCmdList(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			return nil
 		},
 	}
+
+	cmd.Flags().Int("limit", 0, "Max number of results to return (paginates the results).")
+	cmd.Flags().String("cursor", "", "Cursor (from the previous page) to start returning results from.")
+
+	return cmd
 }
 
 // GetCmdGetResource queries a record record.
@@ -134,7 +141,7 @@ func GetCmdGetResource(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 // GetCmdNames queries all naming records.
 func GetCmdNames(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "names",
 		Short: "List name records.",
 		Args:  cobra.ExactArgs(0),
@@ -143,7 +150,8 @@ func GetCmdNames(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/names", queryRoute), nil)
+			pageParams := wnsTypes.PageParamsPath(viper.GetInt("limit"), viper.GetString("cursor"))
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/names%s", queryRoute, pageParams), nil)
 			if err != nil {
 				return err
 			}
@@ -153,6 +161,11 @@ func GetCmdNames(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			return nil
 		},
 	}
+
+	cmd.Flags().Int("limit", 0, "Max number of results to return (paginates the results).")
+	cmd.Flags().String("cursor", "", "Cursor (from the previous page) to start returning results from.")
+
+	return cmd
 }
 
 // GetCmdResolve resolves a WRN to a record.
@@ -181,7 +194,7 @@ func GetCmdResolve(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 // GetCmdQueryByBond queries records by bond ID.
 func GetCmdQueryByBond(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "query-by-bond [bond-id]",
 		Short: "Query records by bond ID.",
 		Args:  cobra.ExactArgs(1),
@@ -190,7 +203,8 @@ func GetCmdQueryByBond(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
 			bondID := args[0]
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/query-by-bond/%s", queryRoute, bondID), nil)
+			pageParams := wnsTypes.PageParamsPath(viper.GetInt("limit"), viper.GetString("cursor"))
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/query-by-bond/%s%s", queryRoute, bondID, pageParams), nil)
 			if err != nil {
 				return err
 			}
@@ -200,6 +214,11 @@ func GetCmdQueryByBond(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			return nil
 		},
 	}
+
+	cmd.Flags().Int("limit", 0, "Max number of results to return (paginates the results).")
+	cmd.Flags().String("cursor", "", "Cursor (from the previous page) to start returning results from.")
+
+	return cmd
 }
 
 // GetCmdQueryParams implements the params query command.
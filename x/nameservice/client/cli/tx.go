@@ -7,6 +7,8 @@ package cli
 import (
 	"fmt"
 	"io/ioutil"
+	"strconv"
+	"strings"
 
 	"github.com/ghodss/yaml"
 	"github.com/spf13/cobra"
@@ -37,12 +39,18 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 	nameserviceTxCmd.AddCommand(client.PostCommands(
 		GetCmdSetRecord(cdc),
 		GetCmdRenewRecord(cdc),
+		GetCmdDeleteRecord(cdc),
 		GetCmdAssociateBond(cdc),
 		GetCmdDissociateBond(cdc),
 		GetCmdDissociateRecords(cdc),
 		GetCmdReassociateRecords(cdc),
 
 		GetCmdReserveName(cdc),
+		GetCmdTransferAuthority(cdc),
+		GetCmdAcceptAuthority(cdc),
+		GetCmdSetAuthorityBond(cdc),
+		GetCmdSetAuthorityOwners(cdc),
+		GetCmdSetAuthorityDelegate(cdc),
 		GetCmdSetName(cdc),
 		GetCmdDeleteName(cdc),
 	)...)
@@ -206,6 +214,32 @@ func GetCmdRenewRecord(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdDeleteRecord is the CLI command for deleting a record.
+func GetCmdDeleteRecord(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-record [record-id]",
+		Short: "Delete record (signer must be a record owner); unused rent is refunded to the bond.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgDeleteRecord(args[0], viper.GetBool("unlink-names"), cliCtx.GetFromAddress())
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Bool("unlink-names", false, "Remove names pointing to the record.")
+
+	return cmd
+}
+
 // GetCmdReserveName is the CLI command for reserving a name.
 func GetCmdReserveName(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -224,7 +258,13 @@ func GetCmdReserveName(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			coSigners, err := getCoSigners()
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgReserveAuthority(args[0], cliCtx.GetFromAddress(), ownerAddress)
+			msg.CoSigners = coSigners
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -235,6 +275,189 @@ func GetCmdReserveName(cdc *codec.Codec) *cobra.Command {
 	}
 
 	cmd.Flags().String("owner", "", "Owner address, if creating a sub-authority.")
+	cmd.Flags().StringSlice("co-signers", []string{}, coSignersFlagUsage)
+
+	return cmd
+}
+
+// GetCmdTransferAuthority is the CLI command for transferring a name authority to a new owner.
+func GetCmdTransferAuthority(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-authority [name] [new-owner-address]",
+		Short: "Transfer name authority to a new owner.",
+		Args:  cobra.ExactArgs(2),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			newOwnerAddress, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			coSigners, err := getCoSigners()
+			if err != nil {
+				return err
+			}
+
+			requireAccept := viper.GetBool("require-accept")
+			msg := types.NewMsgTransferAuthority(args[0], newOwnerAddress, requireAccept, cliCtx.GetFromAddress())
+			msg.CoSigners = coSigners
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Bool("require-accept", false, "New owner has to accept the transfer (using accept-authority).")
+	cmd.Flags().StringSlice("co-signers", []string{}, coSignersFlagUsage)
+
+	return cmd
+}
+
+// GetCmdAcceptAuthority is the CLI command for accepting a pending name authority transfer.
+func GetCmdAcceptAuthority(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-authority [name]",
+		Short: "Accept pending name authority transfer.",
+		Args:  cobra.ExactArgs(1),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgAcceptAuthority(args[0], cliCtx.GetFromAddress())
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSetAuthorityBond is the CLI command for setting the bond used to pay authority rent.
+func GetCmdSetAuthorityBond(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-authority-bond [name] [bond-id]",
+		Short: "Set bond used to pay authority rent.",
+		Args:  cobra.ExactArgs(2),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			coSigners, err := getCoSigners()
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAuthorityBond(args[0], args[1], cliCtx.GetFromAddress())
+			msg.CoSigners = coSigners
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringSlice("co-signers", []string{}, coSignersFlagUsage)
+
+	return cmd
+}
+
+// GetCmdSetAuthorityOwners is the CLI command for setting the owners (and signature threshold) of a name authority.
+func GetCmdSetAuthorityOwners(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-authority-owners [name] [owner-addresses] [threshold]",
+		Short: "Set name authority owners (comma separated) and the number of owner signatures required for changes.",
+		Args:  cobra.ExactArgs(3),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			owners, err := parseAddresses(strings.Split(args[1], ","))
+			if err != nil {
+				return err
+			}
+
+			threshold, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			coSigners, err := getCoSigners()
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAuthorityOwners(args[0], owners, threshold, cliCtx.GetFromAddress())
+			msg.CoSigners = coSigners
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringSlice("co-signers", []string{}, coSignersFlagUsage)
+
+	return cmd
+}
+
+// GetCmdSetAuthorityDelegate is the CLI command for granting (or revoking) scoped write access to a name authority.
+func GetCmdSetAuthorityDelegate(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-authority-delegate [name] [delegate-address]",
+		Short: "Set name authority delegate permissions (no permissions removes the delegate).",
+		Args:  cobra.ExactArgs(2),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			delegateAddress, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			coSigners, err := getCoSigners()
+			if err != nil {
+				return err
+			}
+
+			permissions := viper.GetStringSlice("permissions")
+			msg := types.NewMsgSetAuthorityDelegate(args[0], delegateAddress, permissions, cliCtx.GetFromAddress())
+			msg.CoSigners = coSigners
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringSlice("permissions", []string{}, fmt.Sprintf("Delegate permissions (%s, %s, %s).",
+		types.PermissionSetName, types.PermissionDeleteName, types.PermissionSubAuthority))
+	cmd.Flags().StringSlice("co-signers", []string{}, coSignersFlagUsage)
 
 	return cmd
 }
@@ -250,8 +473,14 @@ func GetCmdSetName(cdc *codec.Codec) *cobra.Command {
 
 			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
 
+			coSigners, err := getCoSigners()
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgSetName(args[0], args[1], cliCtx.GetFromAddress())
-			err := msg.ValidateBasic()
+			msg.CoSigners = coSigners
+			err = msg.ValidateBasic()
 			if err != nil {
 				return err
 			}
@@ -260,6 +489,8 @@ func GetCmdSetName(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().StringSlice("co-signers", []string{}, coSignersFlagUsage)
+
 	return cmd
 }
 
@@ -273,8 +504,14 @@ func GetCmdDeleteName(cdc *codec.Codec) *cobra.Command {
 
 			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
 
+			coSigners, err := getCoSigners()
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgDeleteName(args[0], cliCtx.GetFromAddress())
-			err := msg.ValidateBasic()
+			msg.CoSigners = coSigners
+			err = msg.ValidateBasic()
 			if err != nil {
 				return err
 			}
@@ -283,6 +520,8 @@ func GetCmdDeleteName(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().StringSlice("co-signers", []string{}, coSignersFlagUsage)
+
 	return cmd
 }
 
@@ -303,6 +542,29 @@ func getPayloadFromFile(filePath string) (types.Payload, error) {
 	return payload, nil
 }
 
+// Usage of the co-signers flag, for authorities controlled by multiple owners.
+const coSignersFlagUsage = "Additional signer addresses, e.g. to meet the authority owner threshold " +
+	"(use --generate-only and have each co-signer sign the transaction)."
+
+// getCoSigners parses the co-signers flag.
+func getCoSigners() ([]sdk.AccAddress, error) {
+	return parseAddresses(viper.GetStringSlice("co-signers"))
+}
+
+func parseAddresses(addresses []string) ([]sdk.AccAddress, error) {
+	var accAddresses []sdk.AccAddress
+	for _, address := range addresses {
+		accAddress, err := sdk.AccAddressFromBech32(strings.TrimSpace(address))
+		if err != nil {
+			return nil, err
+		}
+
+		accAddresses = append(accAddresses, accAddress)
+	}
+
+	return accAddresses, nil
+}
+
 // Sign payload object.
 func signResource(payload types.Payload) error {
 	name := viper.GetString("from")
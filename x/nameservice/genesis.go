@@ -5,6 +5,9 @@
 package nameservice
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/wirelineio/wns/x/nameservice/internal/types"
@@ -42,6 +45,26 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	for _, authorityEntry := range data.Authorities {
+		authority := authorityEntry.Entry
+		if len(authority.Owners) == 0 {
+			continue
+		}
+
+		owners := map[string]bool{}
+		for _, owner := range authority.Owners {
+			if owners[owner] {
+				return fmt.Errorf("authority %s has duplicate owner %s", authorityEntry.Name, owner)
+			}
+
+			owners[owner] = true
+		}
+
+		if authority.Threshold == 0 || authority.Threshold > uint64(len(authority.Owners)) {
+			return fmt.Errorf("authority %s has invalid threshold %d for %d owners", authorityEntry.Name, authority.Threshold, len(authority.Owners))
+		}
+	}
+
 	return nil
 }
 
@@ -68,12 +91,56 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) []abci.Valid
 	}
 
 	for _, authorityEntry := range data.Authorities {
-		keeper.SetNameAuthority(ctx, authorityEntry.Name, authorityEntry.Entry.OwnerAddress, authorityEntry.Entry.OwnerPublicKey)
+		authority := authorityEntry.Entry
+
+		// Heights are kept, as sub-authorities created before their parent authority was (re-)reserved are stale.
+		if authority.Height == 0 {
+			authority.Height = ctx.BlockHeight()
+		}
+
+		// Authorities reserved before authority rent was introduced (exported, then imported on upgrade) don't have a status.
+		if authority.Status == "" {
+			authority.Status = types.AuthorityActive
+		}
+
+		// Top-level authorities without an expiry time (reserved before authority rent, or added to the genesis file by hand)
+		// have to set a bond (to pay rent from) within the grace period, like newly reserved authorities.
+		// Sub-authorities don't pay rent, they expire with their parent authority.
+		if authority.Status == types.AuthorityActive && authority.ExpiryTime.IsZero() && !strings.Contains(authorityEntry.Name, ".") {
+			authority.ExpiryTime = ctx.BlockTime().Add(keeper.AuthorityGracePeriod(ctx))
+		}
+
+		keeper.SaveNameAuthority(ctx, authorityEntry.Name, authority)
+
+		if authority.HasExpired() {
+			continue
+		}
+
+		// Note: Auction genesis runs first, so auctions will already be present.
+		if authority.Status == types.AuthorityUnderAuction {
+			keeper.AddAuthorityToAuctionIndexEntry(ctx, authorityEntry.Name, authority.AuctionID)
+			continue
+		}
+
+		// Add to the expiry queue (sub-authorities don't have an expiry time).
+		if !authority.ExpiryTime.IsZero() {
+			keeper.InsertAuthorityExpiryQueue(ctx, authorityEntry.Name, authority.ExpiryTime)
+		}
+
+		// Note: Bond genesis runs first, so bonds will already be present.
+		if authority.BondID != "" {
+			keeper.AddBondToAuthorityIndexEntry(ctx, authority.BondID, authorityEntry.Name)
+		}
 	}
 
 	for _, nameEntry := range data.Names {
 		if nameEntry.Entry.ID != "" {
-			keeper.SetNameRecord(ctx, nameEntry.Name, nameEntry.Entry.ID)
+			height := nameEntry.Entry.Height
+			if height == 0 {
+				height = ctx.BlockHeight()
+			}
+
+			keeper.SetNameRecordAtHeight(ctx, nameEntry.Name, nameEntry.Entry.ID, height)
 		}
 	}
 
@@ -6,8 +6,10 @@ package nameservice
 
 import (
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wirelineio/wns/x/bond"
 	"github.com/wirelineio/wns/x/nameservice/internal/types"
 )
 
@@ -24,6 +26,16 @@ func NewHandler(keeper Keeper) sdk.Handler {
 			return handleMsgDeleteName(ctx, keeper, msg)
 		case types.MsgReserveAuthority:
 			return handleMsgReserveAuthority(ctx, keeper, msg)
+		case types.MsgTransferAuthority:
+			return handleMsgTransferAuthority(ctx, keeper, msg)
+		case types.MsgAcceptAuthority:
+			return handleMsgAcceptAuthority(ctx, keeper, msg)
+		case types.MsgSetAuthorityBond:
+			return handleMsgSetAuthorityBond(ctx, keeper, msg)
+		case types.MsgSetAuthorityOwners:
+			return handleMsgSetAuthorityOwners(ctx, keeper, msg)
+		case types.MsgSetAuthorityDelegate:
+			return handleMsgSetAuthorityDelegate(ctx, keeper, msg)
 		case types.MsgAssociateBond:
 			return handleMsgAssociateBond(ctx, keeper, msg)
 		case types.MsgDissociateBond:
@@ -34,6 +46,8 @@ func NewHandler(keeper Keeper) sdk.Handler {
 			return handleMsgReassociateRecords(ctx, keeper, msg)
 		case types.MsgRenewRecord:
 			return handleMsgRenewRecord(ctx, keeper, msg)
+		case types.MsgDeleteRecord:
+			return handleMsgDeleteRecord(ctx, keeper, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized nameservice Msg type: %v", msg.Type())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -48,6 +62,19 @@ func handleMsgSetRecord(ctx sdk.Context, keeper Keeper, msg types.MsgSetRecord)
 		return err.Result()
 	}
 
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetRecord,
+			sdk.NewAttribute(types.AttributeKeyRecordID, string(record.ID)),
+			sdk.NewAttribute(types.AttributeKeyBondID, string(record.BondID)),
+		).AppendAttributes(getAttributes(types.AttributeKeyOwner, record.Owners)...),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer.String()),
+		),
+	})
+
 	return sdk.Result{
 		Data:   []byte(record.ID),
 		Events: ctx.EventManager().Events(),
@@ -61,6 +88,45 @@ func handleMsgRenewRecord(ctx sdk.Context, keeper Keeper, msg types.MsgRenewReco
 		return err.Result()
 	}
 
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRenewRecord,
+			sdk.NewAttribute(types.AttributeKeyRecordID, string(record.ID)),
+			sdk.NewAttribute(types.AttributeKeyBondID, string(record.BondID)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer.String()),
+		),
+	})
+
+	return sdk.Result{
+		Data:   []byte(record.ID),
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// Handle MsgDeleteRecord.
+func handleMsgDeleteRecord(ctx sdk.Context, keeper Keeper, msg types.MsgDeleteRecord) sdk.Result {
+	record, err := keeper.ProcessDeleteRecord(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDeleteRecord,
+			sdk.NewAttribute(types.AttributeKeyRecordID, string(record.ID)),
+			sdk.NewAttribute(types.AttributeKeyUnlinkNames, strconv.FormatBool(msg.UnlinkNames)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer.String()),
+		),
+	})
+
 	return sdk.Result{
 		Data:   []byte(record.ID),
 		Events: ctx.EventManager().Events(),
@@ -74,6 +140,19 @@ func handleMsgAssociateBond(ctx sdk.Context, keeper Keeper, msg types.MsgAssocia
 		return err.Result()
 	}
 
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAssociateBond,
+			sdk.NewAttribute(types.AttributeKeyRecordID, string(record.ID)),
+			sdk.NewAttribute(types.AttributeKeyBondID, string(msg.BondID)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer.String()),
+		),
+	})
+
 	return sdk.Result{
 		Data:   []byte(record.ID),
 		Events: ctx.EventManager().Events(),
@@ -82,11 +161,29 @@ func handleMsgAssociateBond(ctx sdk.Context, keeper Keeper, msg types.MsgAssocia
 
 // Handle MsgDissociateBond.
 func handleMsgDissociateBond(ctx sdk.Context, keeper Keeper, msg types.MsgDissociateBond) sdk.Result {
+	var bondID bond.ID
+	if keeper.HasRecord(ctx, msg.ID) {
+		bondID = keeper.GetRecord(ctx, msg.ID).BondID
+	}
+
 	record, err := keeper.ProcessDissociateBond(ctx, msg)
 	if err != nil {
 		return err.Result()
 	}
 
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDissociateBond,
+			sdk.NewAttribute(types.AttributeKeyRecordID, string(record.ID)),
+			sdk.NewAttribute(types.AttributeKeyBondID, string(bondID)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer.String()),
+		),
+	})
+
 	return sdk.Result{
 		Data:   []byte(record.ID),
 		Events: ctx.EventManager().Events(),
@@ -100,6 +197,18 @@ func handleMsgDissociateRecords(ctx sdk.Context, keeper Keeper, msg types.MsgDis
 		return err.Result()
 	}
 
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDissociateRecords,
+			sdk.NewAttribute(types.AttributeKeyBondID, string(msg.BondID)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer.String()),
+		),
+	})
+
 	return sdk.Result{
 		Data:   []byte(bond.ID),
 		Events: ctx.EventManager().Events(),
@@ -113,6 +222,19 @@ func handleMsgReassociateRecords(ctx sdk.Context, keeper Keeper, msg types.MsgRe
 		return err.Result()
 	}
 
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeReassociateRecords,
+			sdk.NewAttribute(types.AttributeKeyOldBondID, string(msg.OldBondID)),
+			sdk.NewAttribute(types.AttributeKeyNewBondID, string(msg.NewBondID)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer.String()),
+		),
+	})
+
 	return sdk.Result{
 		Data:   []byte(bond.ID),
 		Events: ctx.EventManager().Events(),
@@ -126,12 +248,161 @@ func handleMsgReserveAuthority(ctx sdk.Context, keeper Keeper, msg types.MsgRese
 		return err.Result()
 	}
 
+	// Owner isn't set for names that go to auction.
+	var owner string
+	if authority := keeper.GetNameAuthority(ctx, name); authority != nil {
+		owner = authority.OwnerAddress
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeReserveAuthority,
+			sdk.NewAttribute(types.AttributeKeyName, name),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer.String()),
+		),
+	})
+
 	return sdk.Result{
 		Data:   []byte(name),
 		Events: ctx.EventManager().Events(),
 	}
 }
 
+// Handle MsgTransferAuthority.
+func handleMsgTransferAuthority(ctx sdk.Context, keeper Keeper, msg types.MsgTransferAuthority) sdk.Result {
+	err := keeper.ProcessTransferAuthority(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransferAuthority,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, msg.NewOwner.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer.String()),
+		),
+	})
+
+	return sdk.Result{
+		Data:   []byte(msg.Name),
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// Handle MsgAcceptAuthority.
+func handleMsgAcceptAuthority(ctx sdk.Context, keeper Keeper, msg types.MsgAcceptAuthority) sdk.Result {
+	err := keeper.ProcessAcceptAuthority(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAcceptAuthority,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Signer.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer.String()),
+		),
+	})
+
+	return sdk.Result{
+		Data:   []byte(msg.Name),
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// Handle MsgSetAuthorityBond.
+func handleMsgSetAuthorityBond(ctx sdk.Context, keeper Keeper, msg types.MsgSetAuthorityBond) sdk.Result {
+	err := keeper.ProcessSetAuthorityBond(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetAuthorityBond,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyBondID, string(msg.BondID)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer.String()),
+		),
+	})
+
+	return sdk.Result{
+		Data:   []byte(msg.Name),
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// Handle MsgSetAuthorityOwners.
+func handleMsgSetAuthorityOwners(ctx sdk.Context, keeper Keeper, msg types.MsgSetAuthorityOwners) sdk.Result {
+	err := keeper.ProcessSetAuthorityOwners(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetAuthorityOwners,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyThreshold, strconv.FormatUint(msg.Threshold, 10)),
+		).AppendAttributes(getAttributes(types.AttributeKeyOwner, getAddressStrings(msg.Owners))...),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer.String()),
+		),
+	})
+
+	return sdk.Result{
+		Data:   []byte(msg.Name),
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// Handle MsgSetAuthorityDelegate.
+func handleMsgSetAuthorityDelegate(ctx sdk.Context, keeper Keeper, msg types.MsgSetAuthorityDelegate) sdk.Result {
+	err := keeper.ProcessSetAuthorityDelegate(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetAuthorityDelegate,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyDelegate, msg.Delegate.String()),
+		).AppendAttributes(getAttributes(types.AttributeKeyPermission, msg.Permissions)...),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer.String()),
+		),
+	})
+
+	return sdk.Result{
+		Data:   []byte(msg.Name),
+		Events: ctx.EventManager().Events(),
+	}
+}
+
 // Handle MsgSetName.
 func handleMsgSetName(ctx sdk.Context, keeper Keeper, msg types.MsgSetName) sdk.Result {
 	err := keeper.ProcessSetName(ctx, msg)
@@ -139,6 +410,19 @@ func handleMsgSetName(ctx sdk.Context, keeper Keeper, msg types.MsgSetName) sdk.
 		return err.Result()
 	}
 
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetName,
+			sdk.NewAttribute(types.AttributeKeyWRN, msg.WRN),
+			sdk.NewAttribute(types.AttributeKeyRecordID, string(msg.ID)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer.String()),
+		),
+	})
+
 	return sdk.Result{
 		Data:   []byte(msg.WRN),
 		Events: ctx.EventManager().Events(),
@@ -152,8 +436,39 @@ func handleMsgDeleteName(ctx sdk.Context, keeper Keeper, msg types.MsgDeleteName
 		return err.Result()
 	}
 
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDeleteName,
+			sdk.NewAttribute(types.AttributeKeyWRN, msg.WRN),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer.String()),
+		),
+	})
+
 	return sdk.Result{
 		Data:   []byte(msg.WRN),
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+// getAttributes returns an event attribute (with the same key) for each value.
+func getAttributes(key string, values []string) []sdk.Attribute {
+	attributes := []sdk.Attribute{}
+	for _, value := range values {
+		attributes = append(attributes, sdk.NewAttribute(key, value))
+	}
+
+	return attributes
+}
+
+func getAddressStrings(addresses []sdk.AccAddress) []string {
+	values := []string{}
+	for _, address := range addresses {
+		values = append(values, address.String())
+	}
+
+	return values
+}
@@ -0,0 +1,304 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wirelineio/wns/x/auction"
+	"github.com/wirelineio/wns/x/bond"
+	"github.com/wirelineio/wns/x/nameservice/internal/helpers"
+	"github.com/wirelineio/wns/x/nameservice/internal/types"
+)
+
+// Generates Bond ID -> Authorities index key.
+func getBondIDToAuthoritiesIndexKey(bondID bond.ID, name string) []byte {
+	return append(append(PrefixBondIDToAuthoritiesIndex, []byte(bondID)...), []byte(name)...)
+}
+
+// AddBondToAuthorityIndexEntry adds the Bond ID -> [Authority] index entry.
+func (k Keeper) AddBondToAuthorityIndexEntry(ctx sdk.Context, bondID bond.ID, name string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(getBondIDToAuthoritiesIndexKey(bondID, name), []byte{})
+}
+
+// RemoveBondToAuthorityIndexEntry removes the Bond ID -> [Authority] index entry.
+func (k Keeper) RemoveBondToAuthorityIndexEntry(ctx sdk.Context, bondID bond.ID, name string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(getBondIDToAuthoritiesIndexKey(bondID, name))
+}
+
+// Generates the authority under auction index key.
+func getAuctionToAuthorityIndexKey(name string) []byte {
+	return append(PrefixAuctionToAuthorityIndex, []byte(name)...)
+}
+
+// AddAuthorityToAuctionIndexEntry adds the authority under auction index entry.
+func (k Keeper) AddAuthorityToAuctionIndexEntry(ctx sdk.Context, name string, auctionID auction.ID) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(getAuctionToAuthorityIndexKey(name), []byte(auctionID))
+}
+
+// RemoveAuthorityToAuctionIndexEntry removes the authority under auction index entry.
+func (k Keeper) RemoveAuthorityToAuctionIndexEntry(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(getAuctionToAuthorityIndexKey(name))
+}
+
+// createAuthorityAuction starts an auction for a top-level name, the authority is created for the winner.
+func (k Keeper) createAuthorityAuction(ctx sdk.Context, name string, owner sdk.AccAddress) sdk.Error {
+	auctionObj, err := k.auctionKeeper.CreateAuction(ctx, owner)
+	if err != nil {
+		return err
+	}
+
+	// Placeholder authority (without an owner) blocks reservations until the auction is settled.
+	authority := types.NameAuthority{
+		Height:    ctx.BlockHeight(),
+		Status:    types.AuthorityUnderAuction,
+		AuctionID: auctionObj.ID,
+	}
+
+	k.SaveNameAuthority(ctx, name, authority)
+	k.AddAuthorityToAuctionIndexEntry(ctx, name, auctionObj.ID)
+
+	return nil
+}
+
+// ProcessAuthorityAuctions creates authorities for the winners of settled auctions.
+// Names for which there was no winner are marked as expired, so that they can be reserved again.
+func (k Keeper) ProcessAuthorityAuctions(ctx sdk.Context) {
+	names := []string{}
+
+	store := ctx.KVStore(k.storeKey)
+	itr := sdk.KVStorePrefixIterator(store, PrefixAuctionToAuthorityIndex)
+	for ; itr.Valid(); itr.Next() {
+		names = append(names, string(itr.Key()[len(PrefixAuctionToAuthorityIndex):]))
+	}
+	itr.Close()
+
+	for _, name := range names {
+		authority := k.GetNameAuthority(ctx, name)
+		if authority == nil || authority.Status != types.AuthorityUnderAuction {
+			k.RemoveAuthorityToAuctionIndexEntry(ctx, name)
+			continue
+		}
+
+		auctionObj := k.auctionKeeper.GetAuction(ctx, authority.AuctionID)
+		if auctionObj.Status != auction.AuctionStatusCompleted {
+			continue
+		}
+
+		k.RemoveAuthorityToAuctionIndexEntry(ctx, name)
+		k.settleAuthorityAuction(ctx, name, *authority, auctionObj)
+	}
+}
+
+func (k Keeper) settleAuthorityAuction(ctx sdk.Context, name string, authority types.NameAuthority, auctionObj auction.Auction) {
+	if auctionObj.WinnerAddress == "" {
+		authority.Status = types.AuthorityExpired
+		k.SaveNameAuthority(ctx, name, authority)
+		return
+	}
+
+	// The winner has revealed a bid, so the account has a public key.
+	winnerAddress, err := sdk.AccAddressFromBech32(auctionObj.WinnerAddress)
+	if err != nil {
+		k.Logger(ctx).Error("Invalid auction winner address.", "name", name, "winner", auctionObj.WinnerAddress)
+		authority.Status = types.AuthorityExpired
+		k.SaveNameAuthority(ctx, name, authority)
+		return
+	}
+
+	winnerAccount := k.accountKeeper.GetAccount(ctx, winnerAddress)
+	if winnerAccount == nil || winnerAccount.GetPubKey() == nil {
+		authority.Status = types.AuthorityExpired
+		k.SaveNameAuthority(ctx, name, authority)
+		return
+	}
+
+	authority.OwnerAddress = auctionObj.WinnerAddress
+	authority.OwnerPublicKey = helpers.BytesToBase64(winnerAccount.GetPubKey().Bytes())
+	authority.Height = ctx.BlockHeight()
+	authority.Status = types.AuthorityActive
+
+	// Owner has to set a bond (to pay rent from) within the grace period.
+	authority.ExpiryTime = ctx.BlockTime().Add(k.AuthorityGracePeriod(ctx))
+	k.InsertAuthorityExpiryQueue(ctx, name, authority.ExpiryTime)
+
+	k.SaveNameAuthority(ctx, name, authority)
+}
+
+// getAuthorityExpiryQueueTimeKey gets the prefix for the authority expiry queue.
+func getAuthorityExpiryQueueTimeKey(timestamp time.Time) []byte {
+	timeBytes := sdk.FormatTimeBytes(timestamp)
+	return append(PrefixExpiryTimeToAuthoritiesIndex, timeBytes...)
+}
+
+// GetAuthorityExpiryQueueTimeSlice gets a specific authority queue timeslice.
+// A timeslice is a slice of authority names that expire at a certain time.
+func (k Keeper) GetAuthorityExpiryQueueTimeSlice(ctx sdk.Context, timestamp time.Time) (names []string) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(getAuthorityExpiryQueueTimeKey(timestamp))
+	if bz == nil {
+		return []string{}
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &names)
+	return names
+}
+
+// SetAuthorityExpiryQueueTimeSlice sets a specific authority expiry queue timeslice.
+func (k Keeper) SetAuthorityExpiryQueueTimeSlice(ctx sdk.Context, timestamp time.Time, names []string) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(names)
+	store.Set(getAuthorityExpiryQueueTimeKey(timestamp), bz)
+}
+
+// DeleteAuthorityExpiryQueueTimeSlice deletes a specific authority expiry queue timeslice.
+func (k Keeper) DeleteAuthorityExpiryQueueTimeSlice(ctx sdk.Context, timestamp time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(getAuthorityExpiryQueueTimeKey(timestamp))
+}
+
+// InsertAuthorityExpiryQueue inserts an authority name to the appropriate timeslice in the authority expiry queue.
+func (k Keeper) InsertAuthorityExpiryQueue(ctx sdk.Context, name string, expiryTime time.Time) {
+	timeSlice := k.GetAuthorityExpiryQueueTimeSlice(ctx, expiryTime)
+	timeSlice = append(timeSlice, name)
+	k.SetAuthorityExpiryQueueTimeSlice(ctx, expiryTime, timeSlice)
+}
+
+// DeleteAuthorityExpiryQueue deletes an authority name from the authority expiry queue.
+func (k Keeper) DeleteAuthorityExpiryQueue(ctx sdk.Context, name string, authority types.NameAuthority) {
+	timeSlice := k.GetAuthorityExpiryQueueTimeSlice(ctx, authority.ExpiryTime)
+	newTimeSlice := []string{}
+
+	for _, existingName := range timeSlice {
+		if existingName != name {
+			newTimeSlice = append(newTimeSlice, existingName)
+		}
+	}
+
+	if len(newTimeSlice) == 0 {
+		k.DeleteAuthorityExpiryQueueTimeSlice(ctx, authority.ExpiryTime)
+	} else {
+		k.SetAuthorityExpiryQueueTimeSlice(ctx, authority.ExpiryTime, newTimeSlice)
+	}
+}
+
+// AuthorityExpiryQueueIterator returns all the authority expiry queue timeslices from time 0 until endTime.
+func (k Keeper) AuthorityExpiryQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	rangeEndBytes := sdk.InclusiveEndBytes(getAuthorityExpiryQueueTimeKey(endTime))
+	return store.Iterator(PrefixExpiryTimeToAuthoritiesIndex, rangeEndBytes)
+}
+
+// GetAllExpiredAuthorities returns a concatenated list of all the timeslices before currTime.
+func (k Keeper) GetAllExpiredAuthorities(ctx sdk.Context, currTime time.Time) (expiredAuthorityNames []string) {
+	// Gets an iterator for all timeslices from time 0 until the current block header time.
+	itr := k.AuthorityExpiryQueueIterator(ctx, currTime)
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		timeslice := []string{}
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(itr.Value(), &timeslice)
+		expiredAuthorityNames = append(expiredAuthorityNames, timeslice...)
+	}
+
+	return expiredAuthorityNames
+}
+
+// ProcessAuthorityExpiryQueue tries to renew expiring authorities (by collecting rent) else marks them as expired.
+func (k Keeper) ProcessAuthorityExpiryQueue(ctx sdk.Context) {
+	names := k.GetAllExpiredAuthorities(ctx, ctx.BlockHeader().Time)
+	for _, name := range names {
+		authority := k.GetNameAuthority(ctx, name)
+		if authority == nil {
+			continue
+		}
+
+		// If authority doesn't have an associated bond or if bond no longer exists, mark it expired.
+		if authority.BondID == "" || !k.bondKeeper.HasBond(ctx, authority.BondID) {
+			k.expireAuthority(ctx, name, *authority)
+			continue
+		}
+
+		// Try to renew the authority by taking rent.
+		k.TryTakeAuthorityRent(ctx, name, *authority)
+	}
+}
+
+// TryTakeAuthorityRent tries to take rent from the authority bond.
+func (k Keeper) TryTakeAuthorityRent(ctx sdk.Context, name string, authority types.NameAuthority) {
+	rent, err := sdk.ParseCoins(k.AuthorityRent(ctx))
+	if err != nil {
+		panic("Invalid authority rent.")
+	}
+
+	sdkErr := k.bondKeeper.TransferCoinsToModuleAccount(ctx, authority.BondID, types.AuthorityRentModuleAccountName, rent)
+	if sdkErr != nil {
+		// Insufficient funds, mark authority as expired.
+		k.expireAuthority(ctx, name, authority)
+		return
+	}
+
+	// Delete old expiry queue entry, create new one.
+	k.DeleteAuthorityExpiryQueue(ctx, name, authority)
+	authority.ExpiryTime = ctx.BlockHeader().Time.Add(k.AuthorityExpiryTime(ctx))
+	k.InsertAuthorityExpiryQueue(ctx, name, authority.ExpiryTime)
+
+	// Save authority.
+	authority.Status = types.AuthorityActive
+	k.SaveNameAuthority(ctx, name, authority)
+}
+
+func (k Keeper) expireAuthority(ctx sdk.Context, name string, authority types.NameAuthority) {
+	k.DeleteAuthorityExpiryQueue(ctx, name, authority)
+
+	if authority.BondID != "" {
+		k.RemoveBondToAuthorityIndexEntry(ctx, authority.BondID, name)
+	}
+
+	authority.Status = types.AuthorityExpired
+	k.SaveNameAuthority(ctx, name, authority)
+}
+
+// ProcessSetAuthorityBond sets the bond used to pay rent for an authority.
+func (k Keeper) ProcessSetAuthorityBond(ctx sdk.Context, msg types.MsgSetAuthorityBond) sdk.Error {
+	authority := k.GetNameAuthority(ctx, msg.Name)
+	if authority == nil {
+		return sdk.ErrInternal("Name authority not found.")
+	}
+
+	sdkErr := checkAuthorityAccess(authority, msg.GetSigners(), "")
+	if sdkErr != nil {
+		return sdkErr
+	}
+
+	if authority.HasExpired() {
+		return sdk.ErrInternal("Name authority has expired.")
+	}
+
+	if !k.bondKeeper.HasBond(ctx, msg.BondID) {
+		return sdk.ErrInternal("Bond not found.")
+	}
+
+	bondObj := k.bondKeeper.GetBond(ctx, msg.BondID)
+	if bondObj.Owner != msg.Signer.String() {
+		return sdk.ErrUnauthorized("Bond owner mismatch.")
+	}
+
+	if authority.BondID != "" {
+		k.RemoveBondToAuthorityIndexEntry(ctx, authority.BondID, msg.Name)
+	}
+
+	authority.BondID = msg.BondID
+	k.AddBondToAuthorityIndexEntry(ctx, msg.BondID, msg.Name)
+	k.SaveNameAuthority(ctx, msg.Name, *authority)
+
+	return nil
+}
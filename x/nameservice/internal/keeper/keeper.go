@@ -7,9 +7,13 @@ package keeper
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/json"
+	"fmt"
 	"sort"
+	"strings"
 	"time"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -17,7 +21,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/supply"
 	set "github.com/deckarep/golang-set"
 	"github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/libs/log"
+	wnsTypes "github.com/wirelineio/wns/types"
+	"github.com/wirelineio/wns/x/auction"
 	"github.com/wirelineio/wns/x/bond"
+	"github.com/wirelineio/wns/x/nameservice/internal/helpers"
 	"github.com/wirelineio/wns/x/nameservice/internal/types"
 )
 
@@ -38,23 +46,53 @@ var PrefixBondIDToRecordsIndex = []byte{0x03}
 // PrefixBlockChangesetIndex is the prefix for the block changeset index.
 var PrefixBlockChangesetIndex = []byte{0x04}
 
+// PrefixBondIDToAuthoritiesIndex is the prefix for the Bond ID -> [Authority] index.
+var PrefixBondIDToAuthoritiesIndex = []byte{0x05}
+
+// PrefixAuctionToAuthorityIndex is the prefix for the index of authorities under auction (name -> Auction ID).
+var PrefixAuctionToAuthorityIndex = []byte{0x06}
+
 // PrefixExpiryTimeToRecordsIndex is the prefix for the Expiry Time -> [Record] index.
 var PrefixExpiryTimeToRecordsIndex = []byte{0x10}
 
+// PrefixExpiryTimeToAuthoritiesIndex is the prefix for the Expiry Time -> [Authority] index.
+var PrefixExpiryTimeToAuthoritiesIndex = []byte{0x11}
+
 // KeySyncStatus is the key for the sync status record.
 // Only used by WNS lite but defined here to prevent conflicts with existing prefixes.
 var KeySyncStatus = []byte{0xff}
 
+// PrefixStoreKeyToProofIndex is the prefix for the store key -> merkle proof (of the synced value) index.
+// Only used by WNS lite but defined here to prevent conflicts with existing prefixes.
+var PrefixStoreKeyToProofIndex = []byte{0xfe}
+
+// KeySyncFilter is the key for the sync filter (partial sync) config.
+// Only used by WNS lite but defined here to prevent conflicts with existing prefixes.
+var KeySyncFilter = []byte{0xfd}
+
+// PrefixBondStore is the prefix for the (synced) bond module store.
+// Only used by WNS lite but defined here to prevent conflicts with existing prefixes.
+var PrefixBondStore = []byte{0xfc}
+
+// PrefixHeightToUndoDataIndex is the prefix for the height -> undo data (to roll back synced state) index.
+// Only used by WNS lite but defined here to prevent conflicts with existing prefixes.
+var PrefixHeightToUndoDataIndex = []byte{0xfb}
+
 // PrefixCIDToNamesIndex the the reverse index for naming, i.e. maps CID -> []Names.
 // TODO(ashwin): Move out of WNS once we have an indexing service.
 var PrefixCIDToNamesIndex = []byte{0xe0}
 
+// PrefixAttributeToRecordsIndex is the prefix for the attribute (key, value) -> [Record] index.
+// TODO(ashwin): Move out of WNS once we have an indexing service.
+var PrefixAttributeToRecordsIndex = []byte{0xe1}
+
 // Keeper maintains the link to storage and exposes getter/setter methods for the various parts of the state machine
 type Keeper struct {
 	accountKeeper auth.AccountKeeper
 	supplyKeeper  supply.Keeper
 	recordKeeper  RecordKeeper
 	bondKeeper    bond.BondClientKeeper
+	auctionKeeper auction.AuctionClientKeeper
 
 	storeKey sdk.StoreKey // Unexposed key to access store from sdk.Context
 
@@ -64,18 +102,25 @@ type Keeper struct {
 }
 
 // NewKeeper creates new instances of the nameservice Keeper
-func NewKeeper(accountKeeper auth.AccountKeeper, supplyKeeper supply.Keeper, recordKeeper RecordKeeper, bondKeeper bond.BondClientKeeper, storeKey sdk.StoreKey, cdc *codec.Codec, paramstore params.Subspace) Keeper {
+func NewKeeper(accountKeeper auth.AccountKeeper, supplyKeeper supply.Keeper, recordKeeper RecordKeeper, bondKeeper bond.BondClientKeeper,
+	auctionKeeper auction.AuctionClientKeeper, storeKey sdk.StoreKey, cdc *codec.Codec, paramstore params.Subspace) Keeper {
 	return Keeper{
 		accountKeeper: accountKeeper,
 		supplyKeeper:  supplyKeeper,
 		recordKeeper:  recordKeeper,
 		bondKeeper:    bondKeeper,
+		auctionKeeper: auctionKeeper,
 		storeKey:      storeKey,
 		cdc:           cdc,
 		paramstore:    paramstore.WithKeyTable(ParamKeyTable()),
 	}
 }
 
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
 // RecordKeeper exposes the bare minimal read-only API for other modules.
 type RecordKeeper struct {
 	storeKey sdk.StoreKey // Unexposed key to access store from sdk.Context
@@ -97,6 +142,7 @@ func NewRecordKeeper(storeKey sdk.StoreKey, cdc *codec.Codec) RecordKeeper {
 func (k Keeper) PutRecord(ctx sdk.Context, record types.Record) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(GetRecordIndexKey(record.ID), k.cdc.MustMarshalBinaryBare(record.ToRecordObj()))
+	AddRecordToAttributeIndex(store, record)
 	k.updateBlockChangesetForRecord(ctx, record.ID)
 }
 
@@ -167,6 +213,65 @@ func RemoveRecordToNameMapping(store sdk.KVStore, codec *amino.Codec, id types.I
 	}
 }
 
+// Generates attribute (key, value) -> Records index prefix.
+// Returns false if the value type isn't indexed (only scalars and references are).
+func getAttributeIndexPrefix(key string, value interface{}) ([]byte, bool) {
+	switch val := value.(type) {
+	case string, bool, float64:
+	case map[string]interface{}:
+		// References, i.e. {"/": "<CID>"}.
+		if _, ok := val["/"].(string); !ok || len(val) != 1 {
+			return nil, false
+		}
+	default:
+		return nil, false
+	}
+
+	// JSON encoding keeps types distinct and never contains a raw 0x00 byte, so it's safe to use as a separator.
+	keyBytes, err := json.Marshal(key)
+	if err != nil {
+		return nil, false
+	}
+
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return nil, false
+	}
+
+	indexPrefix := append(append([]byte{}, PrefixAttributeToRecordsIndex...), keyBytes...)
+	indexPrefix = append(append(indexPrefix, 0x00), valueBytes...)
+
+	return append(indexPrefix, 0x00), true
+}
+
+// AddRecordToAttributeIndex adds index entries for the top-level record attributes.
+// Records are content addressed, so attributes never change for a given ID.
+func AddRecordToAttributeIndex(store sdk.KVStore, record types.Record) {
+	for key, value := range record.Attributes {
+		if indexPrefix, ok := getAttributeIndexPrefix(key, value); ok {
+			store.Set(append(indexPrefix, []byte(record.ID)...), []byte{})
+		}
+	}
+}
+
+// GetRecordIDsByAttribute gets the IDs of records with the given attribute value, using the attribute index.
+func GetRecordIDsByAttribute(store sdk.KVStore, key string, value interface{}) ([]string, bool) {
+	indexPrefix, ok := getAttributeIndexPrefix(key, value)
+	if !ok {
+		return nil, false
+	}
+
+	ids := []string{}
+
+	itr := sdk.KVStorePrefixIterator(store, indexPrefix)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		ids = append(ids, string(itr.Key()[len(indexPrefix):]))
+	}
+
+	return ids, true
+}
+
 // SetNameRecord - sets a name record.
 func SetNameRecord(store sdk.KVStore, codec *amino.Codec, wrn string, id types.ID, height int64) {
 	nameRecordIndexKey := GetNameRecordIndexKey(wrn)
@@ -198,7 +303,12 @@ func SetNameRecord(store sdk.KVStore, codec *amino.Codec, wrn string, id types.I
 
 // SetNameRecord - sets a name record.
 func (k Keeper) SetNameRecord(ctx sdk.Context, wrn string, id types.ID) {
-	SetNameRecord(ctx.KVStore(k.storeKey), k.cdc, wrn, id, ctx.BlockHeight())
+	k.SetNameRecordAtHeight(ctx, wrn, id, ctx.BlockHeight())
+}
+
+// SetNameRecordAtHeight - sets a name record, created at the given height (e.g. on genesis import).
+func (k Keeper) SetNameRecordAtHeight(ctx sdk.Context, wrn string, id types.ID, height int64) {
+	SetNameRecord(ctx.KVStore(k.storeKey), k.cdc, wrn, id, height)
 
 	// Update changeset for name.
 	k.updateBlockChangesetForName(ctx, wrn)
@@ -234,6 +344,32 @@ func GetRecord(store sdk.KVStore, codec *amino.Codec, id types.ID) types.Record
 	return recordObjToRecord(store, codec, obj)
 }
 
+// GetRecordVersions gets the version chain (oldest first) of a record.
+func (k Keeper) GetRecordVersions(ctx sdk.Context, id types.ID) []types.Record {
+	return GetRecordVersions(ctx.KVStore(k.storeKey), k.cdc, id)
+}
+
+// GetRecordVersions gets the version chain (oldest first) of a record.
+func GetRecordVersions(store sdk.KVStore, codec *amino.Codec, id types.ID) []types.Record {
+	if !HasRecord(store, id) {
+		return nil
+	}
+
+	// Walk back to the first version.
+	record := GetRecord(store, codec, id)
+	for record.Predecessor != "" && HasRecord(store, record.Predecessor) {
+		record = GetRecord(store, codec, record.Predecessor)
+	}
+
+	versions := []types.Record{record}
+	for record.Successor != "" && HasRecord(store, record.Successor) {
+		record = GetRecord(store, codec, record.Successor)
+		versions = append(versions, record)
+	}
+
+	return versions
+}
+
 // GetNameRecord - gets a name record from the store.
 func GetNameRecord(store sdk.KVStore, codec *amino.Codec, wrn string) *types.NameRecord {
 	nameRecordKey := GetNameRecordIndexKey(wrn)
@@ -255,21 +391,24 @@ func (k Keeper) GetNameRecord(ctx sdk.Context, wrn string) *types.NameRecord {
 
 // ListRecords - get all records.
 func (k Keeper) ListRecords(ctx sdk.Context) []types.Record {
+	records, _, _ := k.ListRecordsPage(ctx, "", 0)
+	return records
+}
+
+// ListRecordsPage - get a page of records, starting at the cursor.
+func (k Keeper) ListRecordsPage(ctx sdk.Context, cursor string, limit int) ([]types.Record, string, error) {
 	var records []types.Record
 
 	store := ctx.KVStore(k.storeKey)
-	itr := sdk.KVStorePrefixIterator(store, PrefixCIDToRecordIndex)
-	defer itr.Close()
-	for ; itr.Valid(); itr.Next() {
-		bz := store.Get(itr.Key())
-		if bz != nil {
-			var obj types.RecordObj
-			k.cdc.MustUnmarshalBinaryBare(bz, &obj)
-			records = append(records, recordObjToRecord(store, k.cdc, obj))
-		}
-	}
+	nextCursor, err := wnsTypes.Paginate(store, PrefixCIDToRecordIndex, cursor, limit, func(key []byte, bz []byte) bool {
+		var obj types.RecordObj
+		k.cdc.MustUnmarshalBinaryBare(bz, &obj)
+		records = append(records, recordObjToRecord(store, k.cdc, obj))
 
-	return records
+		return true
+	})
+
+	return records, nextCursor, err
 }
 
 // ListNameAuthorityRecords - get all name authority records.
@@ -293,21 +432,24 @@ func (k Keeper) ListNameAuthorityRecords(ctx sdk.Context) map[string]types.NameA
 
 // ListNameRecords - get all name records.
 func (k Keeper) ListNameRecords(ctx sdk.Context) map[string]types.NameRecord {
+	nameRecords, _, _ := k.ListNameRecordsPage(ctx, "", 0)
+	return nameRecords
+}
+
+// ListNameRecordsPage - get a page of name records, starting at the cursor.
+func (k Keeper) ListNameRecordsPage(ctx sdk.Context, cursor string, limit int) (map[string]types.NameRecord, string, error) {
 	nameRecords := make(map[string]types.NameRecord)
 
 	store := ctx.KVStore(k.storeKey)
-	itr := sdk.KVStorePrefixIterator(store, PrefixWRNToNameRecordIndex)
-	defer itr.Close()
-	for ; itr.Valid(); itr.Next() {
-		bz := store.Get(itr.Key())
-		if bz != nil {
-			var record types.NameRecord
-			k.cdc.MustUnmarshalBinaryBare(bz, &record)
-			nameRecords[string(itr.Key()[len(PrefixWRNToNameRecordIndex):])] = record
-		}
-	}
+	nextCursor, err := wnsTypes.Paginate(store, PrefixWRNToNameRecordIndex, cursor, limit, func(key []byte, bz []byte) bool {
+		var record types.NameRecord
+		k.cdc.MustUnmarshalBinaryBare(bz, &record)
+		nameRecords[string(key[len(PrefixWRNToNameRecordIndex):])] = record
 
-	return nameRecords
+		return true
+	})
+
+	return nameRecords, nextCursor, err
 }
 
 // ResolveWRN resolves a WRN to a record.
@@ -316,6 +458,8 @@ func (k Keeper) ResolveWRN(ctx sdk.Context, wrn string) *types.Record {
 }
 
 // ResolveWRN resolves a WRN to a record.
+// If the WRN isn't registered and its fragment is a semver constraint (e.g. wrn://acme/bot/foo#^1.2),
+// resolves to the record for the highest matching version.
 func ResolveWRN(store sdk.KVStore, codec *amino.Codec, wrn string) *types.Record {
 	nameKey := GetNameRecordIndexKey(wrn)
 
@@ -333,54 +477,155 @@ func ResolveWRN(store sdk.KVStore, codec *amino.Codec, wrn string) *types.Record
 		return &record
 	}
 
-	return nil
+	return resolveWRNVersion(store, codec, wrn)
 }
 
-// MatchRecords - get all matching records.
-func (k Keeper) MatchRecords(ctx sdk.Context, matchFn func(*types.Record) bool) []*types.Record {
-	return MatchRecords(ctx.KVStore(k.storeKey), k.cdc, matchFn)
-}
+// resolveWRNVersion resolves a WRN with a semver constraint fragment to the highest matching version.
+func resolveWRNVersion(store sdk.KVStore, codec *amino.Codec, wrn string) *types.Record {
+	index := strings.LastIndex(wrn, "#")
+	if index < 0 {
+		return nil
+	}
 
-// MatchRecords - get all matching records.
-func MatchRecords(store sdk.KVStore, codec *amino.Codec, matchFn func(*types.Record) bool) []*types.Record {
-	var records []*types.Record
+	constraint, err := semver.NewConstraint(wrn[index+1:])
+	if err != nil {
+		return nil
+	}
+
+	var latestVersion *semver.Version
+	var latestRecord *types.Record
 
-	itr := sdk.KVStorePrefixIterator(store, PrefixCIDToRecordIndex)
+	versionsPrefix := GetNameRecordIndexKey(wrn[:index+1])
+	itr := sdk.KVStorePrefixIterator(store, versionsPrefix)
 	defer itr.Close()
 	for ; itr.Valid(); itr.Next() {
-		bz := store.Get(itr.Key())
+		version, err := semver.NewVersion(string(itr.Key()[len(versionsPrefix):]))
+		if err != nil || !constraint.Check(version) {
+			continue
+		}
+
+		if latestVersion != nil && !version.GreaterThan(latestVersion) {
+			continue
+		}
+
+		var obj types.NameRecord
+		codec.MustUnmarshalBinaryBare(itr.Value(), &obj)
+		if obj.ID == "" || !HasRecord(store, obj.ID) {
+			continue
+		}
+
+		record := GetRecord(store, codec, obj.ID)
+		if record.Deleted {
+			continue
+		}
+
+		latestVersion = version
+		latestRecord = &record
+	}
+
+	return latestRecord
+}
+
+// MatchRecords - get a page of matching records, starting at the cursor.
+func (k Keeper) MatchRecords(ctx sdk.Context, attributes map[string]interface{}, cursor string, limit int, matchFn func(*types.Record) bool) ([]*types.Record, string, error) {
+	return MatchRecords(ctx.KVStore(k.storeKey), k.cdc, attributes, cursor, limit, matchFn)
+}
+
+// MatchRecords - get a page of matching records, starting at the cursor.
+// Attributes (exact match) are looked up using the attribute index, matchFn is applied to the resulting records.
+// Falls back to a full scan if none of the attributes are indexed.
+func MatchRecords(store sdk.KVStore, codec *amino.Codec, attributes map[string]interface{}, cursor string, limit int, matchFn func(*types.Record) bool) ([]*types.Record, string, error) {
+	var ids []string
+	indexed := false
+
+	for key, value := range attributes {
+		postings, ok := GetRecordIDsByAttribute(store, key, value)
+		if !ok {
+			continue
+		}
+
+		if indexed {
+			ids = helpers.Intersection(ids, postings)
+		} else {
+			ids = postings
+			indexed = true
+		}
+
+		if len(ids) == 0 {
+			return nil, "", nil
+		}
+	}
+
+	var records []*types.Record
+	matchRecord := func(bz []byte) bool {
+		var obj types.RecordObj
+		codec.MustUnmarshalBinaryBare(bz, &obj)
+		record := recordObjToRecord(store, codec, obj)
+		if matchFn(&record) {
+			records = append(records, &record)
+			return true
+		}
+
+		return false
+	}
+
+	if !indexed {
+		nextCursor, err := wnsTypes.Paginate(store, PrefixCIDToRecordIndex, cursor, limit, func(key []byte, bz []byte) bool {
+			return matchRecord(bz)
+		})
+
+		return records, nextCursor, err
+	}
+
+	start, err := wnsTypes.DecodeCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Same order (and so, cursors) as a full scan of the CID -> Record index.
+	sort.Strings(ids)
+
+	for _, id := range ids[sort.SearchStrings(ids, string(start)):] {
+		if limit > 0 && len(records) == limit {
+			return records, wnsTypes.EncodeCursor([]byte(id)), nil
+		}
+
+		bz := store.Get(GetRecordIndexKey(types.ID(id)))
 		if bz != nil {
-			var obj types.RecordObj
-			codec.MustUnmarshalBinaryBare(bz, &obj)
-			record := recordObjToRecord(store, codec, obj)
-			if matchFn(&record) {
-				records = append(records, &record)
-			}
+			matchRecord(bz)
 		}
 	}
 
-	return records
+	return records, "", nil
 }
 
 // QueryRecordsByBond - get all records for the given bond.
 func (k RecordKeeper) QueryRecordsByBond(ctx sdk.Context, bondID bond.ID) []types.Record {
+	records, _, _ := k.QueryRecordsByBondPage(ctx, bondID, "", 0)
+	return records
+}
+
+// QueryRecordsByBondPage - get a page of records for the given bond, starting at the cursor.
+func (k RecordKeeper) QueryRecordsByBondPage(ctx sdk.Context, bondID bond.ID, cursor string, limit int) ([]types.Record, string, error) {
 	var records []types.Record
 
 	bondIDPrefix := append(PrefixBondIDToRecordsIndex, []byte(bondID)...)
 	store := ctx.KVStore(k.storeKey)
-	itr := sdk.KVStorePrefixIterator(store, bondIDPrefix)
-	defer itr.Close()
-	for ; itr.Valid(); itr.Next() {
-		cid := itr.Key()[len(bondIDPrefix):]
+	nextCursor, err := wnsTypes.Paginate(store, bondIDPrefix, cursor, limit, func(key []byte, _ []byte) bool {
+		cid := key[len(bondIDPrefix):]
 		bz := store.Get(append(PrefixCIDToRecordIndex, cid...))
-		if bz != nil {
-			var obj types.RecordObj
-			k.cdc.MustUnmarshalBinaryBare(bz, &obj)
-			records = append(records, recordObjToRecord(store, k.cdc, obj))
+		if bz == nil {
+			return false
 		}
-	}
 
-	return records
+		var obj types.RecordObj
+		k.cdc.MustUnmarshalBinaryBare(bz, &obj)
+		records = append(records, recordObjToRecord(store, k.cdc, obj))
+
+		return true
+	})
+
+	return records, nextCursor, err
 }
 
 // ModuleName returns the module name.
@@ -388,13 +633,22 @@ func (k RecordKeeper) ModuleName() string {
 	return types.ModuleName
 }
 
-// UsesBond returns true if the bond has associated records.
+// UsesBond returns true if the bond has associated records or authorities.
 func (k RecordKeeper) UsesBond(ctx sdk.Context, bondID bond.ID) bool {
-	bondIDPrefix := append(PrefixBondIDToRecordsIndex, []byte(bondID)...)
 	store := ctx.KVStore(k.storeKey)
-	itr := sdk.KVStorePrefixIterator(store, bondIDPrefix)
-	defer itr.Close()
-	return itr.Valid()
+
+	for _, prefix := range [][]byte{PrefixBondIDToRecordsIndex, PrefixBondIDToAuthoritiesIndex} {
+		bondIDPrefix := append(append([]byte{}, prefix...), []byte(bondID)...)
+		itr := sdk.KVStorePrefixIterator(store, bondIDPrefix)
+		used := itr.Valid()
+		itr.Close()
+
+		if used {
+			return true
+		}
+	}
+
+	return false
 }
 
 // getRecordExpiryQueueTimeKey gets the prefix for the record expiry queue.
@@ -488,6 +742,7 @@ func (k Keeper) ProcessRecordExpiryQueue(ctx sdk.Context) {
 			record.Deleted = true
 			k.PutRecord(ctx, record)
 			k.DeleteRecordExpiryQueue(ctx, record)
+			emitRecordEvent(ctx, types.EventTypeRecordExpiry, record)
 
 			return
 		}
@@ -499,6 +754,13 @@ func (k Keeper) ProcessRecordExpiryQueue(ctx sdk.Context) {
 
 // TryTakeRecordRent tries to take rent from the record bond.
 func (k Keeper) TryTakeRecordRent(ctx sdk.Context, record types.Record) {
+	// Records deleted by their owners are never renewed.
+	if record.DeletedByOwner {
+		k.DeleteRecordExpiryQueue(ctx, record)
+
+		return
+	}
+
 	rent, err := sdk.ParseCoins(k.RecordRent(ctx))
 	if err != nil {
 		panic("Invalid record rent.")
@@ -510,6 +772,7 @@ func (k Keeper) TryTakeRecordRent(ctx sdk.Context, record types.Record) {
 		record.Deleted = true
 		k.PutRecord(ctx, record)
 		k.DeleteRecordExpiryQueue(ctx, record)
+		emitRecordEvent(ctx, types.EventTypeRecordExpiry, record)
 
 		return
 	}
@@ -523,6 +786,17 @@ func (k Keeper) TryTakeRecordRent(ctx sdk.Context, record types.Record) {
 	record.Deleted = false
 	k.PutRecord(ctx, record)
 	k.AddBondToRecordIndexEntry(ctx, record.BondID, record.ID)
+	emitRecordEvent(ctx, types.EventTypeRecordRenewal, record)
+}
+
+func emitRecordEvent(ctx sdk.Context, eventType string, record types.Record) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyRecordID, string(record.ID)),
+			sdk.NewAttribute(types.AttributeKeyBondID, string(record.BondID)),
+		),
+	)
 }
 
 func int64ToBytes(num int64) []byte {
@@ -535,6 +809,20 @@ func GetBlockChangesetIndexKey(height int64) []byte {
 	return append(PrefixBlockChangesetIndex, int64ToBytes(height)...)
 }
 
+// GetBlockChangeset gets the changeset for a block (nil if nothing changed at that height).
+func (k Keeper) GetBlockChangeset(ctx sdk.Context, height int64) *types.BlockChangeset {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetBlockChangesetIndexKey(height))
+	if bz == nil {
+		return nil
+	}
+
+	var changeset types.BlockChangeset
+	k.cdc.MustUnmarshalBinaryBare(bz, &changeset)
+
+	return &changeset
+}
+
 func (k Keeper) getOrCreateBlockChangeset(ctx sdk.Context, height int64) *types.BlockChangeset {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(GetBlockChangesetIndexKey(height))
@@ -588,15 +876,10 @@ func HasNameAuthority(store sdk.KVStore, name string) bool {
 	return store.Has(GetNameAuthorityIndexKey(name))
 }
 
-// SetNameAuthority creates the NameAutority record.
-func (k Keeper) SetNameAuthority(ctx sdk.Context, name string, ownerAddress string, ownerPublicKey string) {
+// SaveNameAuthority saves (creates or updates) the NameAuthority record.
+func (k Keeper) SaveNameAuthority(ctx sdk.Context, name string, authority types.NameAuthority) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(GetNameAuthorityIndexKey(name), k.cdc.MustMarshalBinaryBare(
-		types.NameAuthority{
-			OwnerAddress:   ownerAddress,
-			OwnerPublicKey: ownerPublicKey,
-			Height:         ctx.BlockHeight(),
-		}))
+	store.Set(GetNameAuthorityIndexKey(name), k.cdc.MustMarshalBinaryBare(authority))
 	k.updateBlockChangesetForNameAuthority(ctx, name)
 }
 
@@ -635,7 +918,7 @@ func recordObjToRecord(store sdk.KVStore, codec *amino.Codec, obj types.RecordOb
 // GetModuleBalances gets the nameservice module account(s) balances.
 func (k Keeper) GetModuleBalances(ctx sdk.Context) map[string]sdk.Coins {
 	balances := map[string]sdk.Coins{}
-	accountNames := []string{types.RecordRentModuleAccountName}
+	accountNames := []string{types.RecordRentModuleAccountName, types.AuthorityRentModuleAccountName}
 
 	for _, accountName := range accountNames {
 		moduleAddress := k.supplyKeeper.GetModuleAddress(accountName)
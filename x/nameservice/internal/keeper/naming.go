@@ -28,8 +28,9 @@ func (k Keeper) ProcessReserveAuthority(ctx sdk.Context, msg types.MsgReserveAut
 		return "", sdk.ErrInternal("Invalid name.")
 	}
 
-	// Check if name already reserved.
-	if k.HasNameAuthority(ctx, name) {
+	// Check if name already reserved (expired and stale authorities can be reserved again).
+	existingAuthority := k.GetNameAuthority(ctx, name)
+	if existingAuthority != nil && !k.hasAuthorityTreeExpired(ctx, name) {
 		return "", sdk.ErrInternal("Name already exists.")
 	}
 
@@ -38,7 +39,7 @@ func (k Keeper) ProcessReserveAuthority(ctx sdk.Context, msg types.MsgReserveAut
 	}
 
 	// Reserve name with signer as owner.
-	sdkErr := k.createAuthority(ctx, name, msg.Signer)
+	sdkErr := k.createAuthority(ctx, name, msg.Signer, true)
 	if sdkErr != nil {
 		return "", sdkErr
 	}
@@ -46,7 +47,13 @@ func (k Keeper) ProcessReserveAuthority(ctx sdk.Context, msg types.MsgReserveAut
 	return name, nil
 }
 
-func (k Keeper) createAuthority(ctx sdk.Context, name string, owner sdk.AccAddress) sdk.Error {
+// createAuthority creates a name authority, top-level authorities need to pay rent (after the grace period).
+// If auctions are enabled, top-level authorities are instead created for the winner of an auction for the name.
+func (k Keeper) createAuthority(ctx sdk.Context, name string, owner sdk.AccAddress, isRoot bool) sdk.Error {
+	if isRoot && k.AuthorityAuctions(ctx) {
+		return k.createAuthorityAuction(ctx, name, owner)
+	}
+
 	ownerAccount := k.accountKeeper.GetAccount(ctx, owner)
 	if ownerAccount == nil {
 		return sdk.ErrUnknownAddress("Account not found.")
@@ -57,12 +64,20 @@ func (k Keeper) createAuthority(ctx sdk.Context, name string, owner sdk.AccAddre
 		return sdk.ErrInvalidPubKey("Account public key not set.")
 	}
 
-	k.SetNameAuthority(
-		ctx,
-		name,
-		owner.String(),
-		helpers.BytesToBase64(pubKey.Bytes()),
-	)
+	authority := types.NameAuthority{
+		OwnerAddress:   owner.String(),
+		OwnerPublicKey: helpers.BytesToBase64(pubKey.Bytes()),
+		Height:         ctx.BlockHeight(),
+		Status:         types.AuthorityActive,
+	}
+
+	if isRoot {
+		// Owner has to set a bond (to pay rent from) within the grace period.
+		authority.ExpiryTime = ctx.BlockTime().Add(k.AuthorityGracePeriod(ctx))
+		k.InsertAuthorityExpiryQueue(ctx, name, authority.ExpiryTime)
+	}
+
+	k.SaveNameAuthority(ctx, name, authority)
 
 	return nil
 }
@@ -79,9 +94,14 @@ func (k Keeper) ProcessReserveSubAuthority(ctx sdk.Context, name string, msg typ
 		return name, sdk.ErrInternal("Parent authority not found.")
 	}
 
-	// Sub-authority creator needs to be the owner of the parent authority.
-	if parentAuthority.OwnerAddress != msg.Signer.String() {
-		return name, sdk.ErrUnauthorized("Access denied.")
+	if k.hasAuthorityTreeExpired(ctx, parent) {
+		return name, sdk.ErrInternal("Parent authority has expired.")
+	}
+
+	// Sub-authority creator needs to be the owner (or a delegate) of the parent authority.
+	sdkErr := checkAuthorityAccess(parentAuthority, msg.GetSigners(), types.PermissionSubAuthority)
+	if sdkErr != nil {
+		return name, sdkErr
 	}
 
 	// Sub-authority owner defaults to parent authority owner.
@@ -91,7 +111,7 @@ func (k Keeper) ProcessReserveSubAuthority(ctx sdk.Context, name string, msg typ
 		subAuthorityOwner = msg.Owner
 	}
 
-	sdkErr := k.createAuthority(ctx, name, subAuthorityOwner)
+	sdkErr = k.createAuthority(ctx, name, subAuthorityOwner, false)
 	if sdkErr != nil {
 		return "", sdkErr
 	}
@@ -99,7 +119,183 @@ func (k Keeper) ProcessReserveSubAuthority(ctx sdk.Context, name string, msg typ
 	return name, nil
 }
 
-func (k Keeper) checkWRN(ctx sdk.Context, signer sdk.AccAddress, inputWRN string) sdk.Error {
+// ProcessTransferAuthority transfers a name authority to a new owner (or initiates the transfer, if acceptance is required).
+func (k Keeper) ProcessTransferAuthority(ctx sdk.Context, msg types.MsgTransferAuthority) sdk.Error {
+	authority := k.GetNameAuthority(ctx, msg.Name)
+	if authority == nil {
+		return sdk.ErrInternal("Name authority not found.")
+	}
+
+	sdkErr := checkAuthorityAccess(authority, msg.GetSigners(), "")
+	if sdkErr != nil {
+		return sdkErr
+	}
+
+	if k.hasAuthorityTreeExpired(ctx, msg.Name) {
+		return sdk.ErrInternal("Name authority has expired.")
+	}
+
+	if msg.NewOwner.String() == authority.OwnerAddress {
+		// Transfer to self cancels any pending transfer.
+		authority.PendingOwnerAddress = ""
+		k.SaveNameAuthority(ctx, msg.Name, *authority)
+
+		return nil
+	}
+
+	if msg.RequireAccept {
+		authority.PendingOwnerAddress = msg.NewOwner.String()
+		k.SaveNameAuthority(ctx, msg.Name, *authority)
+
+		return nil
+	}
+
+	return k.setAuthorityOwner(ctx, msg.Name, authority, msg.NewOwner)
+}
+
+// ProcessAcceptAuthority completes a pending name authority transfer.
+func (k Keeper) ProcessAcceptAuthority(ctx sdk.Context, msg types.MsgAcceptAuthority) sdk.Error {
+	authority := k.GetNameAuthority(ctx, msg.Name)
+	if authority == nil {
+		return sdk.ErrInternal("Name authority not found.")
+	}
+
+	if authority.PendingOwnerAddress == "" || authority.PendingOwnerAddress != msg.Signer.String() {
+		return sdk.ErrUnauthorized("Access denied.")
+	}
+
+	if k.hasAuthorityTreeExpired(ctx, msg.Name) {
+		return sdk.ErrInternal("Name authority has expired.")
+	}
+
+	return k.setAuthorityOwner(ctx, msg.Name, authority, msg.Signer)
+}
+
+func (k Keeper) setAuthorityOwner(ctx sdk.Context, name string, authority *types.NameAuthority, owner sdk.AccAddress) sdk.Error {
+	ownerAccount := k.accountKeeper.GetAccount(ctx, owner)
+	if ownerAccount == nil {
+		return sdk.ErrUnknownAddress("Account not found.")
+	}
+
+	pubKey := ownerAccount.GetPubKey()
+	if pubKey == nil {
+		return sdk.ErrInvalidPubKey("Account public key not set.")
+	}
+
+	authority.OwnerAddress = owner.String()
+	authority.OwnerPublicKey = helpers.BytesToBase64(pubKey.Bytes())
+	authority.PendingOwnerAddress = ""
+
+	// New owner starts with sole control of the authority.
+	authority.Owners = nil
+	authority.Threshold = 0
+	authority.Delegates = nil
+
+	k.SaveNameAuthority(ctx, name, *authority)
+
+	return nil
+}
+
+// ProcessSetAuthorityOwners sets the owner set (and signature threshold) of a name authority.
+func (k Keeper) ProcessSetAuthorityOwners(ctx sdk.Context, msg types.MsgSetAuthorityOwners) sdk.Error {
+	authority := k.GetNameAuthority(ctx, msg.Name)
+	if authority == nil {
+		return sdk.ErrInternal("Name authority not found.")
+	}
+
+	sdkErr := checkAuthorityAccess(authority, msg.GetSigners(), "")
+	if sdkErr != nil {
+		return sdkErr
+	}
+
+	if k.hasAuthorityTreeExpired(ctx, msg.Name) {
+		return sdk.ErrInternal("Name authority has expired.")
+	}
+
+	ownerAccount := k.accountKeeper.GetAccount(ctx, msg.Owners[0])
+	if ownerAccount == nil {
+		return sdk.ErrUnknownAddress("Account not found.")
+	}
+
+	pubKey := ownerAccount.GetPubKey()
+	if pubKey == nil {
+		return sdk.ErrInvalidPubKey("Account public key not set.")
+	}
+
+	authority.OwnerAddress = msg.Owners[0].String()
+	authority.OwnerPublicKey = helpers.BytesToBase64(pubKey.Bytes())
+	authority.Owners = nil
+	authority.Threshold = 0
+
+	// A single owner doesn't need an owner set.
+	if len(msg.Owners) > 1 {
+		for _, owner := range msg.Owners {
+			authority.Owners = append(authority.Owners, owner.String())
+		}
+
+		authority.Threshold = msg.Threshold
+	}
+
+	k.SaveNameAuthority(ctx, msg.Name, *authority)
+
+	return nil
+}
+
+// ProcessSetAuthorityDelegate adds, updates or removes (if there are no permissions) an authority delegate.
+func (k Keeper) ProcessSetAuthorityDelegate(ctx sdk.Context, msg types.MsgSetAuthorityDelegate) sdk.Error {
+	authority := k.GetNameAuthority(ctx, msg.Name)
+	if authority == nil {
+		return sdk.ErrInternal("Name authority not found.")
+	}
+
+	sdkErr := checkAuthorityAccess(authority, msg.GetSigners(), "")
+	if sdkErr != nil {
+		return sdkErr
+	}
+
+	if k.hasAuthorityTreeExpired(ctx, msg.Name) {
+		return sdk.ErrInternal("Name authority has expired.")
+	}
+
+	delegates := []types.AuthorityDelegate{}
+	for _, delegate := range authority.Delegates {
+		if delegate.Address != msg.Delegate.String() {
+			delegates = append(delegates, delegate)
+		}
+	}
+
+	if len(msg.Permissions) > 0 {
+		delegates = append(delegates, types.AuthorityDelegate{
+			Address:     msg.Delegate.String(),
+			Permissions: msg.Permissions,
+		})
+	}
+
+	authority.Delegates = nil
+	if len(delegates) > 0 {
+		authority.Delegates = delegates
+	}
+
+	k.SaveNameAuthority(ctx, msg.Name, *authority)
+
+	return nil
+}
+
+// checkAuthorityAccess checks if the signers are the owner(s) of the authority, or a delegate with the permission.
+// Operations that require owner approval pass an empty permission.
+func checkAuthorityAccess(authority *types.NameAuthority, signers []sdk.AccAddress, permission string) sdk.Error {
+	if authority.IsApprovedByOwners(signers) {
+		return nil
+	}
+
+	if permission != "" && authority.HasDelegatePermission(signers, permission) {
+		return nil
+	}
+
+	return sdk.ErrUnauthorized("Access denied.")
+}
+
+func (k Keeper) checkWRN(ctx sdk.Context, signers []sdk.AccAddress, permission string, inputWRN string) sdk.Error {
 	parsedWRN, err := url.Parse(inputWRN)
 	if err != nil {
 		return sdk.ErrInternal("Invalid WRN.")
@@ -117,16 +313,39 @@ func (k Keeper) checkWRN(ctx sdk.Context, signer sdk.AccAddress, inputWRN string
 		return sdk.ErrInternal("Name authority not found.")
 	}
 
-	if authority.OwnerAddress != signer.String() {
-		return sdk.ErrUnauthorized("Access denied.")
+	if k.hasAuthorityTreeExpired(ctx, name) {
+		return sdk.ErrInternal("Name authority has expired.")
 	}
 
-	return nil
+	return checkAuthorityAccess(authority, signers, permission)
+}
+
+// hasAuthorityTreeExpired checks if the authority or any of its parent authorities have expired.
+// Sub-authorities created before one of their parent authorities was (re-)reserved are stale, and treated as expired.
+func (k Keeper) hasAuthorityTreeExpired(ctx sdk.Context, name string) bool {
+	labels := strings.Split(name, ".")
+
+	// Walk down from the top-level authority, tracking the latest height an ancestor was reserved at.
+	var ancestorHeight int64
+	for index := len(labels) - 1; index >= 0; index-- {
+		authority := k.GetNameAuthority(ctx, strings.Join(labels[index:], "."))
+		if authority == nil {
+			continue
+		}
+
+		if authority.HasExpired() || authority.Height < ancestorHeight {
+			return true
+		}
+
+		ancestorHeight = authority.Height
+	}
+
+	return false
 }
 
 // ProcessSetName creates a WRN -> Record ID mapping.
 func (k Keeper) ProcessSetName(ctx sdk.Context, msg types.MsgSetName) sdk.Error {
-	err := k.checkWRN(ctx, msg.Signer, msg.WRN)
+	err := k.checkWRN(ctx, msg.GetSigners(), types.PermissionSetName, msg.WRN)
 	if err != nil {
 		return err
 	}
@@ -144,7 +363,7 @@ func (k Keeper) ProcessSetName(ctx sdk.Context, msg types.MsgSetName) sdk.Error
 
 // ProcessDeleteName removes a WRN -> Record ID mapping.
 func (k Keeper) ProcessDeleteName(ctx sdk.Context, msg types.MsgDeleteName) sdk.Error {
-	err := k.checkWRN(ctx, msg.Signer, msg.WRN)
+	err := k.checkWRN(ctx, msg.GetSigners(), types.PermissionDeleteName, msg.WRN)
 	if err != nil {
 		return err
 	}
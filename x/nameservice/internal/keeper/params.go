@@ -33,11 +33,39 @@ func (k Keeper) RecordExpiryTime(ctx sdk.Context) (res time.Duration) {
 	return
 }
 
+// AuthorityRent - get the authority periodic rent.
+func (k Keeper) AuthorityRent(ctx sdk.Context) (res string) {
+	k.paramstore.Get(ctx, types.KeyAuthorityRent, &res)
+	return
+}
+
+// AuthorityExpiryTime - get the authority expiry duration.
+func (k Keeper) AuthorityExpiryTime(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyAuthorityExpiryTime, &res)
+	return
+}
+
+// AuthorityGracePeriod - get the time a new authority has to set a bond to pay rent from.
+func (k Keeper) AuthorityGracePeriod(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyAuthorityGracePeriod, &res)
+	return
+}
+
+// AuthorityAuctions - get whether top-level authorities are auctioned.
+func (k Keeper) AuthorityAuctions(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.KeyAuthorityAuctions, &res)
+	return
+}
+
 // GetParams - Get all parameteras as types.Params.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
 		k.RecordRent(ctx),
 		k.RecordExpiryTime(ctx),
+		k.AuthorityRent(ctx),
+		k.AuthorityExpiryTime(ctx),
+		k.AuthorityGracePeriod(ctx),
+		k.AuthorityAuctions(ctx),
 	)
 }
 
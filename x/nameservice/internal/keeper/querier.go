@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"strings"
 
+	wnsTypes "github.com/wirelineio/wns/types"
 	"github.com/wirelineio/wns/x/bond"
 	"github.com/wirelineio/wns/x/nameservice/internal/types"
 
@@ -94,9 +95,22 @@ func lookupWRN(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Kee
 
 // nolint: unparam
 func listResources(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
-	records := keeper.ListRecords(ctx)
+	limit, cursor, paginated, err2 := wnsTypes.ParsePageParams(path)
+	if err2 != nil {
+		return nil, sdk.ErrUnknownRequest(err2.Error())
+	}
 
-	bz, err2 := json.MarshalIndent(records, "", "  ")
+	records, nextCursor, err2 := keeper.ListRecordsPage(ctx, cursor, limit)
+	if err2 != nil {
+		return nil, sdk.ErrUnknownRequest(err2.Error())
+	}
+
+	var result interface{} = records
+	if paginated {
+		result = types.RecordPage{Records: records, NextCursor: nextCursor}
+	}
+
+	bz, err2 := json.MarshalIndent(result, "", "  ")
 	if err2 != nil {
 		panic("Could not marshal result to JSON.")
 	}
@@ -124,9 +138,22 @@ func getResource(ctx sdk.Context, path []string, req abci.RequestQuery, keeper K
 
 // nolint: unparam
 func listNames(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
-	records := keeper.ListNameRecords(ctx)
+	limit, cursor, paginated, err2 := wnsTypes.ParsePageParams(path)
+	if err2 != nil {
+		return nil, sdk.ErrUnknownRequest(err2.Error())
+	}
+
+	records, nextCursor, err2 := keeper.ListNameRecordsPage(ctx, cursor, limit)
+	if err2 != nil {
+		return nil, sdk.ErrUnknownRequest(err2.Error())
+	}
+
+	var result interface{} = records
+	if paginated {
+		result = types.NameRecordPage{Names: records, NextCursor: nextCursor}
+	}
 
-	bz, err2 := json.MarshalIndent(records, "", "  ")
+	bz, err2 := json.MarshalIndent(result, "", "  ")
 	if err2 != nil {
 		panic("Could not marshal result to JSON.")
 	}
@@ -150,11 +177,27 @@ func resolveName(ctx sdk.Context, path []string, req abci.RequestQuery, keeper K
 
 // nolint: unparam
 func queryRecordsByBond(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	if len(path) == 0 || path[0] == "" {
+		return nil, sdk.ErrUnknownRequest("Bond ID is required.")
+	}
 
-	id := bond.ID(strings.Join(path, "/"))
-	records := keeper.recordKeeper.QueryRecordsByBond(ctx, id)
+	id := bond.ID(path[0])
+	limit, cursor, paginated, err2 := wnsTypes.ParsePageParams(path[1:])
+	if err2 != nil {
+		return nil, sdk.ErrUnknownRequest(err2.Error())
+	}
+
+	records, nextCursor, err2 := keeper.recordKeeper.QueryRecordsByBondPage(ctx, id, cursor, limit)
+	if err2 != nil {
+		return nil, sdk.ErrUnknownRequest(err2.Error())
+	}
+
+	var result interface{} = records
+	if paginated {
+		result = types.RecordPage{Records: records, NextCursor: nextCursor}
+	}
 
-	bz, err2 := json.MarshalIndent(records, "", "  ")
+	bz, err2 := json.MarshalIndent(result, "", "  ")
 	if err2 != nil {
 		panic("Could not marshal result to JSON.")
 	}
@@ -54,14 +54,140 @@ func (k Keeper) ProcessSetRecord(ctx sdk.Context, msg types.MsgSetRecord) (*type
 	// Sort owners list.
 	sort.Strings(record.Owners)
 
-	sdkErr := k.processRecord(ctx, &record, false)
+	predecessor, sdkErr := k.getSupersededRecord(ctx, record)
 	if sdkErr != nil {
 		return nil, sdkErr
 	}
 
+	if predecessor != nil {
+		record.Predecessor = predecessor.ID
+	}
+
+	sdkErr = k.processRecord(ctx, &record, false)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	if predecessor != nil {
+		predecessor.Successor = record.ID
+		k.PutRecord(ctx, *predecessor)
+	}
+
+	return &record, nil
+}
+
+// getSupersededRecord returns the previous version of the record (if any), after checking that it can be superseded.
+func (k Keeper) getSupersededRecord(ctx sdk.Context, record types.Record) (*types.Record, sdk.Error) {
+	id, ok := record.GetSupersedes()
+	if !ok {
+		return nil, nil
+	}
+
+	if !k.HasRecord(ctx, id) {
+		return nil, sdk.ErrInternal("Superseded record not found.")
+	}
+
+	predecessor := k.GetRecord(ctx, id)
+
+	// Version chains are linear, i.e. a record can only be superseded once.
+	if predecessor.Successor != "" {
+		return nil, sdk.ErrInternal("Record already superseded.")
+	}
+
+	// New version has to be published by (at least one of) the same owners.
+	if len(helpers.Intersection(record.Owners, predecessor.Owners)) == 0 {
+		return nil, sdk.ErrUnauthorized("Record owner mismatch.")
+	}
+
+	return &predecessor, nil
+}
+
+// ProcessDeleteRecord deletes a record (on behalf of one of its owners) and refunds the unused rent to its bond.
+func (k Keeper) ProcessDeleteRecord(ctx sdk.Context, msg types.MsgDeleteRecord) (*types.Record, sdk.Error) {
+	if !k.HasRecord(ctx, msg.ID) {
+		return nil, sdk.ErrInternal("Record not found.")
+	}
+
+	record := k.GetRecord(ctx, msg.ID)
+	if record.Deleted {
+		return nil, sdk.ErrInternal("Record already deleted.")
+	}
+
+	// Record owners are derived from the public keys that signed the record.
+	signerAccount := k.accountKeeper.GetAccount(ctx, msg.Signer)
+	if signerAccount == nil || signerAccount.GetPubKey() == nil {
+		return nil, sdk.ErrUnauthorized("Access denied.")
+	}
+
+	signerOwnerID := helpers.GetAddressFromPubKey(signerAccount.GetPubKey())
+	if len(helpers.Intersection(record.Owners, []string{signerOwnerID})) == 0 {
+		return nil, sdk.ErrUnauthorized("Access denied.")
+	}
+
+	sdkErr := k.refundRecordRent(ctx, record)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	k.DeleteRecordExpiryQueue(ctx, record)
+
+	if record.BondID != "" {
+		k.RemoveBondToRecordIndexEntry(ctx, record.BondID, record.ID)
+	}
+
+	if msg.UnlinkNames {
+		for _, wrn := range record.Names {
+			k.SetNameRecord(ctx, wrn, "")
+		}
+	}
+
+	record.BondID = ""
+	record.ExpiryTime = ctx.BlockHeader().Time
+	record.Deleted = true
+	record.DeletedByOwner = true
+	k.PutRecord(ctx, record)
+
 	return &record, nil
 }
 
+// refundRecordRent refunds the unused portion of the current rent period to the record bond.
+func (k Keeper) refundRecordRent(ctx sdk.Context, record types.Record) sdk.Error {
+	if record.BondID == "" || !k.bondKeeper.HasBond(ctx, record.BondID) {
+		return nil
+	}
+
+	period := k.RecordExpiryTime(ctx)
+	remaining := record.ExpiryTime.Sub(ctx.BlockHeader().Time)
+	if period <= 0 || remaining <= 0 {
+		return nil
+	}
+
+	if remaining > period {
+		remaining = period
+	}
+
+	rent, err := sdk.ParseCoins(k.RecordRent(ctx))
+	if err != nil {
+		return sdk.ErrInvalidCoins("Invalid record rent.")
+	}
+
+	refund := sdk.Coins{}
+	for _, coin := range rent {
+		amount := coin.Amount.Mul(sdk.NewInt(int64(remaining))).Quo(sdk.NewInt(int64(period)))
+		if amount.IsPositive() {
+			refund = append(refund, sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	// Rent params might have changed, never refund more than the module account holds.
+	balance := k.supplyKeeper.GetModuleAccount(ctx, types.RecordRentModuleAccountName).GetCoins()
+	if refund.Empty() || !balance.IsAllGTE(refund) {
+		return nil
+	}
+
+	return k.bondKeeper.TransferCoinsFromModuleAccount(ctx, record.BondID, types.RecordRentModuleAccountName, refund)
+}
+
 // ProcessRenewRecord renews a record.
 func (k Keeper) ProcessRenewRecord(ctx sdk.Context, msg types.MsgRenewRecord) (*types.Record, sdk.Error) {
 	if !k.HasRecord(ctx, msg.ID) {
@@ -70,6 +196,10 @@ func (k Keeper) ProcessRenewRecord(ctx sdk.Context, msg types.MsgRenewRecord) (*
 
 	// Check if renewal is required (i.e. expired record marked as deleted).
 	record := k.GetRecord(ctx, msg.ID)
+	if record.DeletedByOwner {
+		return nil, sdk.ErrUnauthorized("Record deleted by owner.")
+	}
+
 	if !record.Deleted || record.ExpiryTime.After(ctx.BlockTime()) {
 		return nil, sdk.ErrInternal("Renewal not required.")
 	}
@@ -125,6 +255,11 @@ func (k Keeper) ProcessAssociateBond(ctx sdk.Context, msg types.MsgAssociateBond
 		return nil, sdk.ErrUnauthorized("Bond already exists.")
 	}
 
+	// Records deleted by their owners can't be brought back (e.g. renewed using another bond).
+	if record.DeletedByOwner {
+		return nil, sdk.ErrUnauthorized("Record deleted by owner.")
+	}
+
 	// Only the bond owner can associate a record with the bond.
 	bond := k.bondKeeper.GetBond(ctx, msg.BondID)
 	if msg.Signer.String() != bond.Owner {
@@ -229,7 +364,7 @@ func (k Keeper) ProcessReassociateRecords(ctx sdk.Context, msg types.MsgReassoci
 		k.AddBondToRecordIndexEntry(ctx, msg.NewBondID, record.ID)
 
 		// Required so that renewal is triggered (with new bond ID) for expired records.
-		if record.Deleted {
+		if record.Deleted && !record.DeletedByOwner {
 			k.InsertRecordExpiryQueue(ctx, record)
 		}
 	}
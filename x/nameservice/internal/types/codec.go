@@ -21,10 +21,16 @@ func init() {
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSetRecord{}, "nameservice/SetRecord", nil)
 	cdc.RegisterConcrete(MsgRenewRecord{}, "nameservice/RenewRecord", nil)
+	cdc.RegisterConcrete(MsgDeleteRecord{}, "nameservice/DeleteRecord", nil)
 
 	cdc.RegisterConcrete(MsgReserveAuthority{}, "nameservice/ReserveAuthority", nil)
 	cdc.RegisterConcrete(MsgSetName{}, "nameservice/SetName", nil)
 	cdc.RegisterConcrete(MsgDeleteName{}, "nameservice/DeleteName", nil)
+	cdc.RegisterConcrete(MsgTransferAuthority{}, "nameservice/TransferAuthority", nil)
+	cdc.RegisterConcrete(MsgAcceptAuthority{}, "nameservice/AcceptAuthority", nil)
+	cdc.RegisterConcrete(MsgSetAuthorityBond{}, "nameservice/SetAuthorityBond", nil)
+	cdc.RegisterConcrete(MsgSetAuthorityOwners{}, "nameservice/SetAuthorityOwners", nil)
+	cdc.RegisterConcrete(MsgSetAuthorityDelegate{}, "nameservice/SetAuthorityDelegate", nil)
 
 	cdc.RegisterConcrete(MsgAssociateBond{}, "nameservice/AssociateBond", nil)
 	cdc.RegisterConcrete(MsgDissociateBond{}, "nameservice/DissociateBond", nil)
@@ -0,0 +1,43 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package types
+
+// Nameservice module event types.
+const (
+	EventTypeSetRecord            = "set-record"
+	EventTypeRenewRecord          = "renew-record"
+	EventTypeDeleteRecord         = "delete-record"
+	EventTypeAssociateBond        = "associate-bond"
+	EventTypeDissociateBond       = "dissociate-bond"
+	EventTypeDissociateRecords    = "dissociate-records"
+	EventTypeReassociateRecords   = "reassociate-records"
+	EventTypeReserveAuthority     = "reserve-authority"
+	EventTypeTransferAuthority    = "transfer-authority"
+	EventTypeAcceptAuthority      = "accept-authority"
+	EventTypeSetAuthorityBond     = "set-authority-bond"
+	EventTypeSetAuthorityOwners   = "set-authority-owners"
+	EventTypeSetAuthorityDelegate = "set-authority-delegate"
+	EventTypeSetName              = "set-name"
+	EventTypeDeleteName           = "delete-name"
+
+	// Emitted by the EndBlocker when an expiring record is renewed (by collecting rent) or deleted.
+	EventTypeRecordRenewal = "record-renewal"
+	EventTypeRecordExpiry  = "record-expiry"
+
+	AttributeKeyRecordID    = "record-id"
+	AttributeKeyBondID      = "bond-id"
+	AttributeKeyOldBondID   = "old-bond-id"
+	AttributeKeyNewBondID   = "new-bond-id"
+	AttributeKeyOwner       = "owner"
+	AttributeKeyNewOwner    = "new-owner"
+	AttributeKeyName        = "name"
+	AttributeKeyWRN         = "wrn"
+	AttributeKeyDelegate    = "delegate"
+	AttributeKeyPermission  = "permission"
+	AttributeKeyThreshold   = "threshold"
+	AttributeKeyUnlinkNames = "unlink-names"
+
+	AttributeValueCategory = ModuleName
+)
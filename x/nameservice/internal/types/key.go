@@ -11,6 +11,9 @@ const (
 	// RecordRentModuleAccountName is the name of the module account that keeps track of record rents paid.
 	RecordRentModuleAccountName = "record_rent"
 
+	// AuthorityRentModuleAccountName is the name of the module account that keeps track of authority rents paid.
+	AuthorityRentModuleAccountName = "authority_rent"
+
 	// StoreKey to be used when creating the KVStore
 	StoreKey = ModuleName
 )
@@ -65,6 +65,52 @@ func (msg MsgSetRecord) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Signer}
 }
 
+// MsgDeleteRecord defines a delete record message.
+type MsgDeleteRecord struct {
+	ID          ID             `json:"id"`
+	UnlinkNames bool           `json:"unlinkNames,omitempty"`
+	Signer      sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgDeleteRecord is the constructor function for MsgDeleteRecord.
+func NewMsgDeleteRecord(id string, unlinkNames bool, signer sdk.AccAddress) MsgDeleteRecord {
+	return MsgDeleteRecord{
+		ID:          ID(id),
+		UnlinkNames: unlinkNames,
+		Signer:      signer,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgDeleteRecord) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgDeleteRecord) Type() string { return "delete" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgDeleteRecord) ValidateBasic() sdk.Error {
+
+	if msg.ID == "" {
+		return sdk.ErrInternal("Record ID is required.")
+	}
+
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress(msg.Signer.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgDeleteRecord) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgDeleteRecord) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
 // MsgRenewRecord defines a renew record message.
 type MsgRenewRecord struct {
 	ID     ID             `json:"id"`
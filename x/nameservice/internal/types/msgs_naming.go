@@ -4,13 +4,19 @@
 
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wirelineio/wns/x/bond"
+)
 
 // MsgReserveAuthority defines a ReserveName message.
 type MsgReserveAuthority struct {
 	Name   string         `json:"name"`
 	Signer sdk.AccAddress `json:"signer"`
 
+	// Additional signers, e.g. to meet the owner threshold of the authority.
+	CoSigners []sdk.AccAddress `json:"coSigners,omitempty"`
+
 	// Owner (instead of Signer) is only used when creating a sub-authority.
 	Owner sdk.AccAddress `json:"owner"`
 }
@@ -41,6 +47,10 @@ func (msg MsgReserveAuthority) ValidateBasic() sdk.Error {
 		return sdk.ErrInvalidAddress(msg.Signer.String())
 	}
 
+	if err := validateCoSigners(msg.CoSigners); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -51,7 +61,7 @@ func (msg MsgReserveAuthority) GetSignBytes() []byte {
 
 // GetSigners Implements Msg.
 func (msg MsgReserveAuthority) GetSigners() []sdk.AccAddress {
-	return []sdk.AccAddress{msg.Signer}
+	return append([]sdk.AccAddress{msg.Signer}, msg.CoSigners...)
 }
 
 // MsgSetName defines a SetName message.
@@ -59,6 +69,9 @@ type MsgSetName struct {
 	WRN    string         `json:"wrn"`
 	ID     ID             `json:"id"`
 	Signer sdk.AccAddress `json:"signer"`
+
+	// Additional signers, e.g. to meet the owner threshold of the authority.
+	CoSigners []sdk.AccAddress `json:"coSigners,omitempty"`
 }
 
 // NewMsgSetName is the constructor function for MsgSetName.
@@ -91,6 +104,10 @@ func (msg MsgSetName) ValidateBasic() sdk.Error {
 		return sdk.ErrInvalidAddress(msg.Signer.String())
 	}
 
+	if err := validateCoSigners(msg.CoSigners); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -101,13 +118,16 @@ func (msg MsgSetName) GetSignBytes() []byte {
 
 // GetSigners Implements Msg.
 func (msg MsgSetName) GetSigners() []sdk.AccAddress {
-	return []sdk.AccAddress{msg.Signer}
+	return append([]sdk.AccAddress{msg.Signer}, msg.CoSigners...)
 }
 
 // MsgDeleteName defines a DeleteName message.
 type MsgDeleteName struct {
 	WRN    string         `json:"wrn"`
 	Signer sdk.AccAddress `json:"signer"`
+
+	// Additional signers, e.g. to meet the owner threshold of the authority.
+	CoSigners []sdk.AccAddress `json:"coSigners,omitempty"`
 }
 
 // NewMsgDeleteName is the constructor function for MsgDeleteName.
@@ -134,6 +154,10 @@ func (msg MsgDeleteName) ValidateBasic() sdk.Error {
 		return sdk.ErrInvalidAddress(msg.Signer.String())
 	}
 
+	if err := validateCoSigners(msg.CoSigners); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -144,5 +168,325 @@ func (msg MsgDeleteName) GetSignBytes() []byte {
 
 // GetSigners Implements Msg.
 func (msg MsgDeleteName) GetSigners() []sdk.AccAddress {
+	return append([]sdk.AccAddress{msg.Signer}, msg.CoSigners...)
+}
+
+// MsgTransferAuthority defines a TransferAuthority message.
+type MsgTransferAuthority struct {
+	Name     string         `json:"name"`
+	NewOwner sdk.AccAddress `json:"newOwner"`
+
+	// If set, the new owner has to accept the transfer (see MsgAcceptAuthority) before it takes effect.
+	RequireAccept bool `json:"requireAccept"`
+
+	Signer sdk.AccAddress `json:"signer"`
+
+	// Additional signers, e.g. to meet the owner threshold of the authority.
+	CoSigners []sdk.AccAddress `json:"coSigners,omitempty"`
+}
+
+// NewMsgTransferAuthority is the constructor function for MsgTransferAuthority.
+func NewMsgTransferAuthority(name string, newOwner sdk.AccAddress, requireAccept bool, signer sdk.AccAddress) MsgTransferAuthority {
+	return MsgTransferAuthority{
+		Name:          name,
+		NewOwner:      newOwner,
+		RequireAccept: requireAccept,
+		Signer:        signer,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgTransferAuthority) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgTransferAuthority) Type() string { return "transfer-authority" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgTransferAuthority) ValidateBasic() sdk.Error {
+
+	if msg.Name == "" {
+		return sdk.ErrInternal("Name is required.")
+	}
+
+	if msg.NewOwner.Empty() {
+		return sdk.ErrInvalidAddress(msg.NewOwner.String())
+	}
+
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress(msg.Signer.String())
+	}
+
+	if err := validateCoSigners(msg.CoSigners); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgTransferAuthority) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgTransferAuthority) GetSigners() []sdk.AccAddress {
+	return append([]sdk.AccAddress{msg.Signer}, msg.CoSigners...)
+}
+
+// MsgAcceptAuthority defines an AcceptAuthority message.
+type MsgAcceptAuthority struct {
+	Name   string         `json:"name"`
+	Signer sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgAcceptAuthority is the constructor function for MsgAcceptAuthority.
+func NewMsgAcceptAuthority(name string, signer sdk.AccAddress) MsgAcceptAuthority {
+	return MsgAcceptAuthority{
+		Name:   name,
+		Signer: signer,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgAcceptAuthority) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgAcceptAuthority) Type() string { return "accept-authority" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgAcceptAuthority) ValidateBasic() sdk.Error {
+
+	if msg.Name == "" {
+		return sdk.ErrInternal("Name is required.")
+	}
+
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress(msg.Signer.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgAcceptAuthority) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgAcceptAuthority) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Signer}
 }
+
+// MsgSetAuthorityBond defines a SetAuthorityBond message.
+type MsgSetAuthorityBond struct {
+	Name   string         `json:"name"`
+	BondID bond.ID        `json:"bondId"`
+	Signer sdk.AccAddress `json:"signer"`
+
+	// Additional signers, e.g. to meet the owner threshold of the authority.
+	CoSigners []sdk.AccAddress `json:"coSigners,omitempty"`
+}
+
+// NewMsgSetAuthorityBond is the constructor function for MsgSetAuthorityBond.
+func NewMsgSetAuthorityBond(name string, bondID string, signer sdk.AccAddress) MsgSetAuthorityBond {
+	return MsgSetAuthorityBond{
+		Name:   name,
+		BondID: bond.ID(bondID),
+		Signer: signer,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgSetAuthorityBond) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSetAuthorityBond) Type() string { return "set-authority-bond" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetAuthorityBond) ValidateBasic() sdk.Error {
+
+	if msg.Name == "" {
+		return sdk.ErrInternal("Name is required.")
+	}
+
+	if msg.BondID == "" {
+		return sdk.ErrInternal("Bond ID is required.")
+	}
+
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress(msg.Signer.String())
+	}
+
+	if err := validateCoSigners(msg.CoSigners); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetAuthorityBond) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetAuthorityBond) GetSigners() []sdk.AccAddress {
+	return append([]sdk.AccAddress{msg.Signer}, msg.CoSigners...)
+}
+
+// MsgSetAuthorityOwners defines a SetAuthorityOwners message.
+type MsgSetAuthorityOwners struct {
+	Name string `json:"name"`
+
+	// Owner set, changes to the authority require signatures from Threshold owners.
+	Owners    []sdk.AccAddress `json:"owners"`
+	Threshold uint64           `json:"threshold"`
+
+	Signer sdk.AccAddress `json:"signer"`
+
+	// Additional signers, e.g. to meet the owner threshold of the authority.
+	CoSigners []sdk.AccAddress `json:"coSigners,omitempty"`
+}
+
+// NewMsgSetAuthorityOwners is the constructor function for MsgSetAuthorityOwners.
+func NewMsgSetAuthorityOwners(name string, owners []sdk.AccAddress, threshold uint64, signer sdk.AccAddress) MsgSetAuthorityOwners {
+	return MsgSetAuthorityOwners{
+		Name:      name,
+		Owners:    owners,
+		Threshold: threshold,
+		Signer:    signer,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgSetAuthorityOwners) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSetAuthorityOwners) Type() string { return "set-authority-owners" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetAuthorityOwners) ValidateBasic() sdk.Error {
+
+	if msg.Name == "" {
+		return sdk.ErrInternal("Name is required.")
+	}
+
+	if len(msg.Owners) == 0 {
+		return sdk.ErrInternal("Owners are required.")
+	}
+
+	owners := map[string]bool{}
+	for _, owner := range msg.Owners {
+		if owner.Empty() {
+			return sdk.ErrInvalidAddress(owner.String())
+		}
+
+		if owners[owner.String()] {
+			return sdk.ErrInternal("Duplicate owner.")
+		}
+
+		owners[owner.String()] = true
+	}
+
+	if msg.Threshold == 0 || msg.Threshold > uint64(len(msg.Owners)) {
+		return sdk.ErrInternal("Invalid threshold.")
+	}
+
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress(msg.Signer.String())
+	}
+
+	if err := validateCoSigners(msg.CoSigners); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetAuthorityOwners) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetAuthorityOwners) GetSigners() []sdk.AccAddress {
+	return append([]sdk.AccAddress{msg.Signer}, msg.CoSigners...)
+}
+
+// MsgSetAuthorityDelegate defines a SetAuthorityDelegate message.
+type MsgSetAuthorityDelegate struct {
+	Name     string         `json:"name"`
+	Delegate sdk.AccAddress `json:"delegate"`
+
+	// Permissions granted to the delegate (no permissions removes the delegate).
+	Permissions []string `json:"permissions"`
+
+	Signer sdk.AccAddress `json:"signer"`
+
+	// Additional signers, e.g. to meet the owner threshold of the authority.
+	CoSigners []sdk.AccAddress `json:"coSigners,omitempty"`
+}
+
+// NewMsgSetAuthorityDelegate is the constructor function for MsgSetAuthorityDelegate.
+func NewMsgSetAuthorityDelegate(name string, delegate sdk.AccAddress, permissions []string, signer sdk.AccAddress) MsgSetAuthorityDelegate {
+	return MsgSetAuthorityDelegate{
+		Name:        name,
+		Delegate:    delegate,
+		Permissions: permissions,
+		Signer:      signer,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgSetAuthorityDelegate) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSetAuthorityDelegate) Type() string { return "set-authority-delegate" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetAuthorityDelegate) ValidateBasic() sdk.Error {
+
+	if msg.Name == "" {
+		return sdk.ErrInternal("Name is required.")
+	}
+
+	if msg.Delegate.Empty() {
+		return sdk.ErrInvalidAddress(msg.Delegate.String())
+	}
+
+	for _, permission := range msg.Permissions {
+		if !IsValidDelegatePermission(permission) {
+			return sdk.ErrInternal("Invalid permission.")
+		}
+	}
+
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress(msg.Signer.String())
+	}
+
+	if err := validateCoSigners(msg.CoSigners); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetAuthorityDelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetAuthorityDelegate) GetSigners() []sdk.AccAddress {
+	return append([]sdk.AccAddress{msg.Signer}, msg.CoSigners...)
+}
+
+func validateCoSigners(coSigners []sdk.AccAddress) sdk.Error {
+	for _, coSigner := range coSigners {
+		if coSigner.Empty() {
+			return sdk.ErrInvalidAddress(coSigner.String())
+		}
+	}
+
+	return nil
+}
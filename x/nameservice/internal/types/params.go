@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
@@ -18,12 +19,29 @@ const (
 
 	// DefaultRecordExpiryTime is the default record expiry time (1 year).
 	DefaultRecordExpiryTime time.Duration = time.Hour * 24 * 365
+
+	// DefaultAuthorityRent is the default authority rent for 1 time period (see expiry time).
+	DefaultAuthorityRent string = "1000000uwire"
+
+	// DefaultAuthorityExpiryTime is the default authority expiry time (1 year).
+	DefaultAuthorityExpiryTime time.Duration = time.Hour * 24 * 365
+
+	// DefaultAuthorityGracePeriod is the default time a new authority has to set a bond to pay rent from (2 days).
+	DefaultAuthorityGracePeriod time.Duration = time.Hour * 24 * 2
+
+	// DefaultAuthorityAuctions is the default for whether top-level authorities are auctioned.
+	DefaultAuthorityAuctions bool = true
 )
 
 // nolint - Keys for parameter access
 var (
 	KeyRecordRent       = []byte("RecordRent")
 	KeyRecordExpiryTime = []byte("RecordExpiryTime")
+
+	KeyAuthorityRent        = []byte("AuthorityRent")
+	KeyAuthorityExpiryTime  = []byte("AuthorityExpiryTime")
+	KeyAuthorityGracePeriod = []byte("AuthorityGracePeriod")
+	KeyAuthorityAuctions    = []byte("AuthorityAuctions")
 )
 
 var _ params.ParamSet = (*Params)(nil)
@@ -32,14 +50,26 @@ var _ params.ParamSet = (*Params)(nil)
 type Params struct {
 	RecordRent       string        `json:"record_rent" yaml:"record_rent"`
 	RecordExpiryTime time.Duration `json:"record_expiry_time" yaml:"record_expiry_time"`
+
+	AuthorityRent        string        `json:"authority_rent" yaml:"authority_rent"`
+	AuthorityExpiryTime  time.Duration `json:"authority_expiry_time" yaml:"authority_expiry_time"`
+	AuthorityGracePeriod time.Duration `json:"authority_grace_period" yaml:"authority_grace_period"`
+	AuthorityAuctions    bool          `json:"authority_auctions" yaml:"authority_auctions"`
 }
 
 // NewParams creates a new Params instance
-func NewParams(recordRent string, recordExpiryTime time.Duration) Params {
+func NewParams(recordRent string, recordExpiryTime time.Duration,
+	authorityRent string, authorityExpiryTime time.Duration, authorityGracePeriod time.Duration,
+	authorityAuctions bool) Params {
 
 	return Params{
 		RecordRent:       recordRent,
 		RecordExpiryTime: recordExpiryTime,
+
+		AuthorityRent:        authorityRent,
+		AuthorityExpiryTime:  authorityExpiryTime,
+		AuthorityGracePeriod: authorityGracePeriod,
+		AuthorityAuctions:    authorityAuctions,
 	}
 }
 
@@ -48,19 +78,30 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		{Key: KeyRecordRent, Value: &p.RecordRent},
 		{Key: KeyRecordExpiryTime, Value: &p.RecordExpiryTime},
+
+		{Key: KeyAuthorityRent, Value: &p.AuthorityRent},
+		{Key: KeyAuthorityExpiryTime, Value: &p.AuthorityExpiryTime},
+		{Key: KeyAuthorityGracePeriod, Value: &p.AuthorityGracePeriod},
+		{Key: KeyAuthorityAuctions, Value: &p.AuthorityAuctions},
 	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams(DefaultRecordRent, DefaultRecordExpiryTime)
+	return NewParams(DefaultRecordRent, DefaultRecordExpiryTime,
+		DefaultAuthorityRent, DefaultAuthorityExpiryTime, DefaultAuthorityGracePeriod, DefaultAuthorityAuctions)
 }
 
 // String returns a human readable string representation of the parameters.
 func (p Params) String() string {
 	return fmt.Sprintf(`Params:
-  Record Rent        : %s
-  Record Expiry Time : %s`, p.RecordRent, p.RecordExpiryTime)
+  Record Rent            : %s
+  Record Expiry Time     : %s
+  Authority Rent         : %s
+  Authority Expiry Time  : %s
+  Authority Grace Period : %s
+  Authority Auctions     : %t`, p.RecordRent, p.RecordExpiryTime,
+		p.AuthorityRent, p.AuthorityExpiryTime, p.AuthorityGracePeriod, p.AuthorityAuctions)
 }
 
 // Validate a set of params.
@@ -69,9 +110,29 @@ func (p Params) Validate() error {
 		return fmt.Errorf("nameservice parameter RecordRent can't be an empty string")
 	}
 
+	if _, err := sdk.ParseCoins(p.RecordRent); err != nil {
+		return fmt.Errorf("nameservice parameter RecordRent is invalid: %s", err)
+	}
+
 	if p.RecordExpiryTime <= 0 {
 		return fmt.Errorf("nameservice parameter RecordExpiryTime must be a positive integer")
 	}
 
+	if p.AuthorityRent == "" {
+		return fmt.Errorf("nameservice parameter AuthorityRent can't be an empty string")
+	}
+
+	if _, err := sdk.ParseCoins(p.AuthorityRent); err != nil {
+		return fmt.Errorf("nameservice parameter AuthorityRent is invalid: %s", err)
+	}
+
+	if p.AuthorityExpiryTime <= 0 {
+		return fmt.Errorf("nameservice parameter AuthorityExpiryTime must be a positive integer")
+	}
+
+	if p.AuthorityGracePeriod <= 0 {
+		return fmt.Errorf("nameservice parameter AuthorityGracePeriod must be a positive integer")
+	}
+
 	return nil
 }
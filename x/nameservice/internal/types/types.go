@@ -10,6 +10,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	canonicalJson "github.com/gibson042/canonicaljson-go"
+	"github.com/wirelineio/wns/x/auction"
 	"github.com/wirelineio/wns/x/bond"
 	"github.com/wirelineio/wns/x/nameservice/internal/helpers"
 )
@@ -27,6 +28,27 @@ type Record struct {
 	Deleted    bool                   `json:"deleted,omitempty"`
 	Owners     []string               `json:"owners,omitempty"`
 	Attributes map[string]interface{} `json:"attributes,omitempty"`
+
+	// Version chain links (see SupersedesAttribute).
+	Predecessor ID `json:"predecessor,omitempty"`
+	Successor   ID `json:"successor,omitempty"`
+
+	// Deleted by one of the owners (as opposed to expired), such records can't be renewed.
+	DeletedByOwner bool `json:"deletedByOwner,omitempty"`
+}
+
+// SupersedesAttribute is the (optional) record attribute with the ID of the previous version of the record.
+// The new version has to be signed by at least one of the owners of the previous version.
+const SupersedesAttribute = "supersedes"
+
+// GetSupersedes returns the ID of the record superseded by this record, if any.
+func (r Record) GetSupersedes() (ID, bool) {
+	value, ok := r.Attributes[SupersedesAttribute].(string)
+	if !ok || value == "" {
+		return "", false
+	}
+
+	return ID(value), true
 }
 
 // GetBondID returns the BondID of the Record.
@@ -66,6 +88,9 @@ func (r *Record) ToRecordObj() RecordObj {
 	resourceObj.Deleted = r.Deleted
 	resourceObj.Owners = r.Owners
 	resourceObj.Attributes = helpers.MarshalMapToJSONBytes(r.Attributes)
+	resourceObj.Predecessor = r.Predecessor
+	resourceObj.Successor = r.Successor
+	resourceObj.DeletedByOwner = r.DeletedByOwner
 
 	return resourceObj
 }
@@ -155,6 +180,11 @@ type RecordObj struct {
 	Deleted    bool      `json:"deleted,omitempty"`
 	Owners     []string  `json:"owners,omitempty"`
 	Attributes []byte    `json:"attributes,omitempty"`
+
+	Predecessor ID `json:"predecessor,omitempty"`
+	Successor   ID `json:"successor,omitempty"`
+
+	DeletedByOwner bool `json:"deletedByOwner,omitempty"`
 }
 
 // ToRecord converts RecordObj to Record.
@@ -169,6 +199,9 @@ func (resourceObj *RecordObj) ToRecord() Record {
 	record.Deleted = resourceObj.Deleted
 	record.Owners = resourceObj.Owners
 	record.Attributes = helpers.UnMarshalMapFromJSONBytes(resourceObj.Attributes)
+	record.Predecessor = resourceObj.Predecessor
+	record.Successor = resourceObj.Successor
+	record.DeletedByOwner = resourceObj.DeletedByOwner
 
 	return record
 }
@@ -190,6 +223,13 @@ func (payload *Payload) ToPayloadObj() PayloadObj {
 	return payloadObj
 }
 
+// Authority status values.
+const (
+	AuthorityActive       = "active"
+	AuthorityExpired      = "expired"
+	AuthorityUnderAuction = "auction"
+)
+
 // NameAuthority records the name/authority ownership info.
 type NameAuthority struct {
 	// Owner public key.
@@ -200,6 +240,103 @@ type NameAuthority struct {
 
 	// Block height at which name/authority was created.
 	Height int64 `json:"height"`
+
+	// Address the authority is being transferred to, pending acceptance by that address.
+	PendingOwnerAddress string `json:"pendingOwnerAddress,omitempty"`
+
+	// Bond used to pay the authority rent.
+	BondID bond.ID `json:"bondId,omitempty"`
+
+	// Time at which the authority expires, unless rent is paid (zero if it never expires).
+	ExpiryTime time.Time `json:"expiryTime,omitempty"`
+
+	// Authority status (active/expired/auction).
+	Status string `json:"status,omitempty"`
+
+	// Auction for the name, if the authority was created by an auction.
+	AuctionID auction.ID `json:"auctionId,omitempty"`
+
+	// Owner set (OwnerAddress is the first owner), if the authority is controlled by multiple owners.
+	Owners []string `json:"owners,omitempty"`
+
+	// Number of owners that need to sign changes to the authority (if there's an owner set).
+	Threshold uint64 `json:"threshold,omitempty"`
+
+	// Delegates with scoped write access to the authority.
+	Delegates []AuthorityDelegate `json:"delegates,omitempty"`
+}
+
+// Authority delegate permissions (named after the messages they allow).
+const (
+	PermissionSetName      = "set-name"
+	PermissionDeleteName   = "delete-name"
+	PermissionSubAuthority = "reserve-authority"
+)
+
+// IsValidDelegatePermission checks if the permission can be granted to a delegate.
+func IsValidDelegatePermission(permission string) bool {
+	switch permission {
+	case PermissionSetName, PermissionDeleteName, PermissionSubAuthority:
+		return true
+	}
+
+	return false
+}
+
+// AuthorityDelegate is an address with scoped write access to an authority.
+type AuthorityDelegate struct {
+	Address     string   `json:"address"`
+	Permissions []string `json:"permissions"`
+}
+
+// HasExpired returns true if the authority has expired (failed to pay rent).
+func (authority NameAuthority) HasExpired() bool {
+	return authority.Status == AuthorityExpired
+}
+
+// IsApprovedByOwners checks if the signers include the owner (or the threshold number of owners, if there's an owner set).
+// Owner sets with an invalid threshold (zero) approve nothing.
+func (authority NameAuthority) IsApprovedByOwners(signers []sdk.AccAddress) bool {
+	signerSet := map[string]bool{}
+	for _, signer := range signers {
+		signerSet[signer.String()] = true
+	}
+
+	if len(authority.Owners) == 0 {
+		return authority.OwnerAddress != "" && signerSet[authority.OwnerAddress]
+	}
+
+	if authority.Threshold == 0 {
+		return false
+	}
+
+	var approvals uint64
+	for _, owner := range authority.Owners {
+		if signerSet[owner] {
+			approvals++
+		}
+	}
+
+	return approvals >= authority.Threshold
+}
+
+// HasDelegatePermission checks if any of the signers is a delegate with the given permission.
+func (authority NameAuthority) HasDelegatePermission(signers []sdk.AccAddress, permission string) bool {
+	for _, delegate := range authority.Delegates {
+		for _, signer := range signers {
+			if delegate.Address != signer.String() {
+				continue
+			}
+
+			for _, delegatePermission := range delegate.Permissions {
+				if delegatePermission == permission {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
 }
 
 // NameRecordEntry is a naming record entry for a WRN.
@@ -226,3 +363,15 @@ type BlockChangeset struct {
 	NameAuthorities []string `json:"authorities"`
 	Names           []string `json:"names"`
 }
+
+// RecordPage is a page of records, with the cursor for the next page.
+type RecordPage struct {
+	Records    []Record `json:"records"`
+	NextCursor string   `json:"nextCursor,omitempty"`
+}
+
+// NameRecordPage is a page of name records, with the cursor for the next page.
+type NameRecordPage struct {
+	Names      map[string]NameRecord `json:"names"`
+	NextCursor string                `json:"nextCursor,omitempty"`
+}